@@ -0,0 +1,274 @@
+package polymarketgamma
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	// DefaultBatchChunkSize is the maximum number of identifiers sent in a single batch request
+	DefaultBatchChunkSize = 50
+	// DefaultBatchMaxQueryLength keeps batch request URLs well below common 8KB server limits
+	DefaultBatchMaxQueryLength = 4000
+	// DefaultBatchConcurrency is the number of batch chunks requested in parallel
+	DefaultBatchConcurrency = 4
+)
+
+// BatchResult holds the results of a batch lookup keyed by the input identifier
+type BatchResult[K comparable, T any] struct {
+	Found    map[K]T // Items keyed by the identifier they were requested with
+	NotFound []K     // Input identifiers the API returned no item for, in input order
+	Failed   []K     // Input identifiers whose request failed, so their existence is unknown, in input order
+}
+
+// BatchOption configures batch lookups such as GetMarketsByConditionIDs
+type BatchOption func(*batchConfig)
+
+type batchConfig struct {
+	chunkSize      int
+	maxQueryLength int
+	concurrency    int
+}
+
+// WithBatchChunkSize sets the maximum number of identifiers per request
+func WithBatchChunkSize(n int) BatchOption {
+	return func(cfg *batchConfig) {
+		cfg.chunkSize = n
+	}
+}
+
+// WithBatchMaxQueryLength sets the maximum encoded query string length per request
+func WithBatchMaxQueryLength(n int) BatchOption {
+	return func(cfg *batchConfig) {
+		cfg.maxQueryLength = n
+	}
+}
+
+// WithBatchConcurrency sets how many chunks are requested in parallel
+func WithBatchConcurrency(n int) BatchOption {
+	return func(cfg *batchConfig) {
+		cfg.concurrency = n
+	}
+}
+
+func newBatchConfig(opts []BatchOption) batchConfig {
+	cfg := batchConfig{
+		chunkSize:      DefaultBatchChunkSize,
+		maxQueryLength: DefaultBatchMaxQueryLength,
+		concurrency:    DefaultBatchConcurrency,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.chunkSize < 1 {
+		cfg.chunkSize = DefaultBatchChunkSize
+	}
+	if cfg.maxQueryLength < 1 {
+		cfg.maxQueryLength = DefaultBatchMaxQueryLength
+	}
+	if cfg.concurrency < 1 {
+		cfg.concurrency = 1
+	}
+	return cfg
+}
+
+// chunkKeys de-duplicates keys by their canonical form and splits them into chunks that respect
+// both the identifier count and the encoded query length of the repeated query parameter
+func chunkKeys[K comparable](keys []K, param string, encode func(K) string, norm func(K) K, cfg batchConfig) [][]K {
+	var chunks [][]K
+	var current []K
+	length := 0
+	seen := make(map[K]struct{}, len(keys))
+
+	for _, key := range keys {
+		if _, ok := seen[norm(key)]; ok {
+			continue
+		}
+		seen[norm(key)] = struct{}{}
+
+		// "&param=value"
+		size := len(url.QueryEscape(param)) + len(url.QueryEscape(encode(key))) + 2
+		if len(current) > 0 && (len(current) >= cfg.chunkSize || length+size > cfg.maxQueryLength) {
+			chunks = append(chunks, current)
+			current = nil
+			length = 0
+		}
+		current = append(current, key)
+		length += size
+	}
+
+	if len(current) > 0 {
+		chunks = append(chunks, current)
+	}
+	return chunks
+}
+
+// runBatch fetches chunks concurrently and indexes the returned items by the keys they match.
+// norm maps both input keys and matched keys to a canonical form before comparison.
+// Chunk failures are joined into the returned error alongside the partial result, and the keys
+// of failed chunks that were not found are reported in Failed rather than NotFound.
+func runBatch[K comparable, T any](
+	ctx context.Context,
+	keys []K,
+	param string,
+	encode func(K) string,
+	norm func(K) K,
+	cfg batchConfig,
+	fetch func(ctx context.Context, chunk []K) ([]T, error),
+	match func(T) []K,
+) (*BatchResult[K, T], error) {
+	// canonical key -> input keys requesting it
+	wanted := make(map[K][]K, len(keys))
+	for _, key := range keys {
+		canonical := norm(key)
+		wanted[canonical] = append(wanted[canonical], key)
+	}
+
+	result := &BatchResult[K, T]{Found: make(map[K]T, len(keys))}
+
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		errs   []error
+		failed = make(map[K]struct{}) // canonical keys of failed chunks
+		sem    = make(chan struct{}, cfg.concurrency)
+	)
+
+	fail := func(chunk []K, err error) {
+		errs = append(errs, err)
+		for _, key := range chunk {
+			failed[norm(key)] = struct{}{}
+		}
+	}
+
+	for _, chunk := range chunkKeys(keys, param, encode, norm, cfg) {
+		wg.Add(1)
+		go func(chunk []K) {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				mu.Lock()
+				fail(chunk, ctx.Err())
+				mu.Unlock()
+				return
+			}
+
+			items, err := fetch(ctx, chunk)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			// Items decoded before a partial decode error are still indexed
+			if err != nil {
+				fail(chunk, fmt.Errorf("batch of %d %s: %w", len(chunk), param, err))
+			}
+			for _, item := range items {
+				for _, key := range match(item) {
+					for _, input := range wanted[norm(key)] {
+						result.Found[input] = item
+					}
+				}
+			}
+		}(chunk)
+	}
+	wg.Wait()
+
+	seen := make(map[K]struct{}, len(keys))
+	for _, key := range keys {
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		if _, ok := result.Found[key]; ok {
+			continue
+		}
+		if _, ok := failed[norm(key)]; ok {
+			result.Failed = append(result.Failed, key)
+		} else {
+			result.NotFound = append(result.NotFound, key)
+		}
+	}
+
+	return result, errors.Join(errs...)
+}
+
+func identity[K any](k K) K { return k }
+
+// GetMarketsByConditionIDs resolves markets by condition ID, splitting the input into URL-safe chunks
+// requested concurrently. Condition IDs are matched case-insensitively.
+func (c *Client) GetMarketsByConditionIDs(ctx context.Context, conditionIDs []string, opts ...BatchOption) (*BatchResult[string, *Market], error) {
+	return runBatch(ctx, conditionIDs, "condition_ids", identity[string], strings.ToLower, newBatchConfig(opts),
+		func(ctx context.Context, chunk []string) ([]*Market, error) {
+			return c.GetMarkets(ctx, &GetMarketsParams{ConditionIDs: chunk, Limit: len(chunk)})
+		},
+		func(m *Market) []string { return []string{m.ConditionID} },
+	)
+}
+
+// GetMarketsByTokenIDs resolves markets by CLOB token ID, splitting the input into URL-safe chunks
+// requested concurrently. Every requested token ID of a market maps to that market.
+func (c *Client) GetMarketsByTokenIDs(ctx context.Context, tokenIDs []string, opts ...BatchOption) (*BatchResult[string, *Market], error) {
+	return runBatch(ctx, tokenIDs, "clob_token_ids", identity[string], identity[string], newBatchConfig(opts),
+		func(ctx context.Context, chunk []string) ([]*Market, error) {
+			return c.GetMarkets(ctx, &GetMarketsParams{ClobTokenIDs: chunk, Limit: len(chunk)})
+		},
+		func(m *Market) []string {
+			ids, _ := m.TokenIDs()
+			return ids
+		},
+	)
+}
+
+// GetMarketsBySlugs resolves markets by slug, splitting the input into URL-safe chunks requested concurrently
+func (c *Client) GetMarketsBySlugs(ctx context.Context, slugs []string, opts ...BatchOption) (*BatchResult[string, *Market], error) {
+	return runBatch(ctx, slugs, "slug", identity[string], identity[string], newBatchConfig(opts),
+		func(ctx context.Context, chunk []string) ([]*Market, error) {
+			return c.GetMarkets(ctx, &GetMarketsParams{Slug: chunk, Limit: len(chunk)})
+		},
+		func(m *Market) []string { return []string{m.Slug} },
+	)
+}
+
+// GetEventsByIDs resolves events by ID, splitting the input into URL-safe chunks requested concurrently
+func (c *Client) GetEventsByIDs(ctx context.Context, eventIDs []int, opts ...BatchOption) (*BatchResult[int, *Event], error) {
+	return runBatch(ctx, eventIDs, "id", strconv.Itoa, identity[int], newBatchConfig(opts),
+		func(ctx context.Context, chunk []int) ([]*Event, error) {
+			events, err := c.GetEvents(ctx, &GetEventsParams{ID: chunk, Limit: len(chunk)})
+			return eventPointers(events), err
+		},
+		func(e *Event) []int {
+			id, err := strconv.Atoi(e.ID)
+			if err != nil {
+				return nil
+			}
+			return []int{id}
+		},
+	)
+}
+
+// GetEventsBySlugs resolves events by slug, splitting the input into URL-safe chunks requested concurrently
+func (c *Client) GetEventsBySlugs(ctx context.Context, slugs []string, opts ...BatchOption) (*BatchResult[string, *Event], error) {
+	return runBatch(ctx, slugs, "slug", identity[string], identity[string], newBatchConfig(opts),
+		func(ctx context.Context, chunk []string) ([]*Event, error) {
+			events, err := c.GetEvents(ctx, &GetEventsParams{Slug: chunk, Limit: len(chunk)})
+			return eventPointers(events), err
+		},
+		func(e *Event) []string { return []string{e.Slug} },
+	)
+}
+
+func eventPointers(events []Event) []*Event {
+	ptrs := make([]*Event, len(events))
+	for i := range events {
+		ptrs[i] = &events[i]
+	}
+	return ptrs
+}
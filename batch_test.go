@@ -0,0 +1,156 @@
+package polymarketgamma
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestChunkKeys(t *testing.T) {
+	keys := []string{"a", "b", "a", "c", "d", "e"}
+
+	chunks := chunkKeys(keys, "slug", identity[string], identity[string], batchConfig{chunkSize: 2, maxQueryLength: 1000})
+	if fmt.Sprint(chunks) != "[[a b] [c d] [e]]" {
+		t.Errorf("chunks by count = %v", chunks)
+	}
+
+	// Each key costs len("slug")+len("x")+2 = 7 bytes
+	chunks = chunkKeys(keys, "slug", identity[string], identity[string], batchConfig{chunkSize: 100, maxQueryLength: 15})
+	if fmt.Sprint(chunks) != "[[a b] [c d] [e]]" {
+		t.Errorf("chunks by length = %v", chunks)
+	}
+
+	// Case variants of one identifier are sent once
+	chunks = chunkKeys([]string{"0xAB", "0xab", "0xCD"}, "condition_ids", identity[string], strings.ToLower, batchConfig{chunkSize: 10, maxQueryLength: 1000})
+	if fmt.Sprint(chunks) != "[[0xAB 0xCD]]" {
+		t.Errorf("chunks with normalized keys = %v", chunks)
+	}
+}
+
+func TestGetMarketsByConditionIDs(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		query := r.URL.Query()
+		if query.Get("limit") != fmt.Sprint(len(query["condition_ids"])) {
+			t.Errorf("limit %s does not match chunk size %d", query.Get("limit"), len(query["condition_ids"]))
+		}
+
+		var markets []map[string]any
+		for _, id := range query["condition_ids"] {
+			if strings.HasPrefix(id, "0xmissing") {
+				continue
+			}
+			markets = append(markets, map[string]any{"id": id, "conditionId": strings.ToLower(id)})
+		}
+		json.NewEncoder(w).Encode(markets)
+	}))
+	defer server.Close()
+
+	var ids []string
+	for i := 0; i < 120; i++ {
+		ids = append(ids, fmt.Sprintf("0xABC%03d", i))
+	}
+	ids = append(ids, "0xmissing1", "0xmissing2", "0xabc007")
+
+	client := NewClient(nil, WithBaseURL(server.URL))
+	result, err := client.GetMarketsByConditionIDs(context.Background(), ids, WithBatchChunkSize(25), WithBatchConcurrency(3))
+	if err != nil {
+		t.Fatalf("GetMarketsByConditionIDs failed: %v", err)
+	}
+
+	if requests.Load() != 5 {
+		t.Errorf("requests = %d, want 5", requests.Load())
+	}
+	if len(result.Found) != 121 {
+		t.Errorf("found %d, want 121", len(result.Found))
+	}
+	if market := result.Found["0xABC007"]; market == nil || market.ConditionID != "0xabc007" || result.Found["0xabc007"] != market {
+		t.Errorf("result not keyed by input identifier: %v", market)
+	}
+	if fmt.Sprint(result.NotFound) != "[0xmissing1 0xmissing2]" {
+		t.Errorf("NotFound = %v", result.NotFound)
+	}
+}
+
+func TestGetMarketsByTokenIDs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"id":"1","clobTokenIds":"[\"111\", \"222\"]"},{"id":"2","clobTokenIds":"[\"333\", \"444\"]"}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL))
+	result, err := client.GetMarketsByTokenIDs(context.Background(), []string{"111", "222", "444", "999"})
+	if err != nil {
+		t.Fatalf("GetMarketsByTokenIDs failed: %v", err)
+	}
+
+	if result.Found["111"].ID != "1" || result.Found["222"].ID != "1" || result.Found["444"].ID != "2" {
+		t.Errorf("unexpected matches: %v", result.Found)
+	}
+	if _, ok := result.Found["333"]; ok {
+		t.Error("token 333 was not requested and must not be reported")
+	}
+	if fmt.Sprint(result.NotFound) != "[999]" {
+		t.Errorf("NotFound = %v", result.NotFound)
+	}
+}
+
+func TestGetEventsByIDsAndSlugs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"id":"10","slug":"ten"},{"id":"20","slug":"twenty"}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	byID, err := client.GetEventsByIDs(ctx, []int{10, 20, 30})
+	if err != nil {
+		t.Fatalf("GetEventsByIDs failed: %v", err)
+	}
+	if byID.Found[10].Slug != "ten" || byID.Found[20].Slug != "twenty" || fmt.Sprint(byID.NotFound) != "[30]" {
+		t.Errorf("unexpected result: %+v", byID)
+	}
+
+	bySlug, err := client.GetEventsBySlugs(ctx, []string{"ten", "eleven"})
+	if err != nil {
+		t.Fatalf("GetEventsBySlugs failed: %v", err)
+	}
+	if bySlug.Found["ten"].ID != "10" || fmt.Sprint(bySlug.NotFound) != "[eleven]" {
+		t.Errorf("unexpected result: %+v", bySlug)
+	}
+}
+
+func TestGetMarketsBySlugsPartialFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		slugs := r.URL.Query()["slug"]
+		if slugs[0] == "bad" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		var markets []map[string]any
+		for _, slug := range slugs {
+			markets = append(markets, map[string]any{"id": slug, "slug": slug})
+		}
+		json.NewEncoder(w).Encode(markets)
+	}))
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL))
+	result, err := client.GetMarketsBySlugs(context.Background(), []string{"good", "bad"}, WithBatchChunkSize(1))
+	if !IsServerError(err) {
+		t.Fatalf("expected joined server error, got %v", err)
+	}
+	if result.Found["good"] == nil {
+		t.Error("successful chunks must still be returned")
+	}
+	if len(result.NotFound) != 0 || fmt.Sprint(result.Failed) != "[bad]" {
+		t.Errorf("NotFound = %v, Failed = %v; want the failed chunk in Failed only", result.NotFound, result.Failed)
+	}
+}
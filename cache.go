@@ -0,0 +1,283 @@
+package polymarketgamma
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CacheConfig configures the in-memory response cache
+type CacheConfig struct {
+	DefaultTTL time.Duration            // TTL for endpoints without an entry in TTLs
+	TTLs       map[string]time.Duration // Per endpoint family TTL, e.g. {"/markets": 30 * time.Second}; 0 disables caching for that family
+	MaxEntries int                      // LRU size cap (0 = unlimited)
+}
+
+// CacheStats reports in-memory cache activity
+type CacheStats struct {
+	Hits      uint64 // Requests served from the cache
+	Misses    uint64 // Requests that had to go to the API
+	Shared    uint64 // Requests collapsed into an identical in-flight request
+	Evictions uint64 // Entries removed to respect MaxEntries
+	Entries   int    // Entries currently stored
+}
+
+// WithCache enables an in-memory LRU response cache keyed by request path.
+// Identical concurrent requests are collapsed into a single API call.
+func WithCache(cfg CacheConfig) Option {
+	return func(c *Client) {
+		c.cache = newResponseCache(cfg)
+	}
+}
+
+type cacheBypassKey struct{}
+
+// WithCacheBypass returns a context that skips cache lookups for calls made with it.
+// Fresh responses still refresh the cache.
+func WithCacheBypass(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cacheBypassKey{}, true)
+}
+
+func cacheBypassed(ctx context.Context) bool {
+	bypass, _ := ctx.Value(cacheBypassKey{}).(bool)
+	return bypass
+}
+
+// CacheStats returns in-memory cache statistics (zero if caching is disabled)
+func (c *Client) CacheStats() CacheStats {
+	if c.cache == nil {
+		return CacheStats{}
+	}
+	return c.cache.stats()
+}
+
+// InvalidateCache removes cached responses whose request path starts with prefix,
+// e.g. "/markets/slug/foo" or "/events". An empty prefix clears the whole cache.
+func (c *Client) InvalidateCache(prefix string) {
+	if c.cache != nil {
+		c.cache.invalidate(prefix)
+	}
+}
+
+type cacheEntry struct {
+	key     string
+	body    []byte
+	expires time.Time
+}
+
+type responseCache struct {
+	cfg CacheConfig
+
+	mu      sync.Mutex
+	lru     *list.List // front = most recently used
+	entries map[string]*list.Element
+	counts  CacheStats
+
+	flights flightGroup
+}
+
+func newResponseCache(cfg CacheConfig) *responseCache {
+	// Accept both "markets" and "/markets" as endpoint family keys
+	ttls := make(map[string]time.Duration, len(cfg.TTLs))
+	for endpoint, ttl := range cfg.TTLs {
+		ttls["/"+strings.Trim(endpoint, "/")] = ttl
+	}
+	cfg.TTLs = ttls
+
+	return &responseCache{
+		cfg:     cfg,
+		lru:     list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// ttl returns the TTL for a request path
+func (rc *responseCache) ttl(path string) time.Duration {
+	if ttl, ok := rc.cfg.TTLs[endpointFamily(path)]; ok {
+		return ttl
+	}
+	return rc.cfg.DefaultTTL
+}
+
+func (rc *responseCache) get(key string) ([]byte, bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	elem, ok := rc.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := elem.Value.(*cacheEntry)
+	if time.Now().After(entry.expires) {
+		rc.lru.Remove(elem)
+		delete(rc.entries, key)
+		return nil, false
+	}
+
+	rc.lru.MoveToFront(elem)
+	return entry.body, true
+}
+
+func (rc *responseCache) set(key string, body []byte) {
+	ttl := rc.ttl(key)
+	if ttl <= 0 {
+		return
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if elem, ok := rc.entries[key]; ok {
+		entry := elem.Value.(*cacheEntry)
+		entry.body = body
+		entry.expires = time.Now().Add(ttl)
+		rc.lru.MoveToFront(elem)
+		return
+	}
+
+	rc.entries[key] = rc.lru.PushFront(&cacheEntry{key: key, body: body, expires: time.Now().Add(ttl)})
+
+	for rc.cfg.MaxEntries > 0 && rc.lru.Len() > rc.cfg.MaxEntries {
+		oldest := rc.lru.Back()
+		rc.lru.Remove(oldest)
+		delete(rc.entries, oldest.Value.(*cacheEntry).key)
+		rc.counts.Evictions++
+	}
+}
+
+func (rc *responseCache) invalidate(prefix string) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	for key, elem := range rc.entries {
+		if strings.HasPrefix(key, prefix) {
+			rc.lru.Remove(elem)
+			delete(rc.entries, key)
+		}
+	}
+}
+
+func (rc *responseCache) record(hit, shared bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	switch {
+	case hit:
+		rc.counts.Hits++
+	case shared:
+		rc.counts.Shared++
+	default:
+		rc.counts.Misses++
+	}
+}
+
+func (rc *responseCache) stats() CacheStats {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	stats := rc.counts
+	stats.Entries = rc.lru.Len()
+	return stats
+}
+
+// fetch serves path from the cache or calls load, collapsing concurrent loads of the same path.
+// The shared load runs detached from any one caller's cancellation, and each caller stops waiting
+// when its own ctx is done; the load is cancelled once no caller is left waiting for it.
+// hit reports whether the body came from the cache; attempts is only reported to the caller that
+// started the load. Every caller gets its own copy of the body, so interceptors may modify it in place
+// without corrupting the cache entry or another caller's response.
+func (rc *responseCache) fetch(ctx context.Context, path string, load func(ctx context.Context) ([]byte, int, error)) (body []byte, hit bool, attempts int, err error) {
+	if !cacheBypassed(ctx) {
+		if body, ok := rc.get(path); ok {
+			rc.record(true, false)
+			return bytes.Clone(body), true, 0, nil
+		}
+	}
+
+	result, shared, err := rc.flights.do(ctx, path, func(ctx context.Context) flightResult {
+		body, attempts, err := load(ctx)
+		if err == nil {
+			rc.set(path, body)
+		}
+		return flightResult{body: body, attempts: attempts, err: err}
+	})
+	rc.record(false, shared)
+	if err != nil {
+		return nil, false, 0, err
+	}
+	if shared {
+		result.attempts = 0
+	}
+	return bytes.Clone(result.body), false, result.attempts, result.err
+}
+
+// flightGroup collapses concurrent calls with the same key into one execution
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+type flightResult struct {
+	body     []byte
+	attempts int
+	err      error
+}
+
+type flightCall struct {
+	done    chan struct{}
+	result  flightResult
+	waiters int
+	cancel  context.CancelFunc
+}
+
+// do runs fn once per key at a time; callers arriving while it runs share its result. fn runs in its
+// own goroutine on a context that keeps ctx's values but not its cancellation. A caller whose ctx is
+// done returns ctx.Err() without waiting, and when the last caller leaves, fn's context is cancelled.
+func (g *flightGroup) do(ctx context.Context, key string, fn func(ctx context.Context) flightResult) (flightResult, bool, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*flightCall)
+	}
+	call, shared := g.calls[key]
+	if shared {
+		call.waiters++
+	} else {
+		loadCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		call = &flightCall{done: make(chan struct{}), waiters: 1, cancel: cancel}
+		g.calls[key] = call
+
+		go func() {
+			defer cancel()
+			call.result = fn(loadCtx)
+
+			g.mu.Lock()
+			if g.calls[key] == call {
+				delete(g.calls, key)
+			}
+			g.mu.Unlock()
+			close(call.done)
+		}()
+	}
+	g.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.result, shared, nil
+	case <-ctx.Done():
+		g.mu.Lock()
+		call.waiters--
+		if call.waiters == 0 {
+			// Nobody wants the result any more: stop the load and let the next caller start afresh
+			call.cancel()
+			if g.calls[key] == call {
+				delete(g.calls, key)
+			}
+		}
+		g.mu.Unlock()
+		return flightResult{}, shared, ctx.Err()
+	}
+}
@@ -0,0 +1,324 @@
+package polymarketgamma
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newCountingServer(t *testing.T, requests *atomic.Int32, delay time.Duration) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		time.Sleep(delay)
+		if r.URL.Path == "/events/slug/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{"id":"1","slug":"` + r.URL.Path + `"}`))
+	}))
+}
+
+func TestCacheHitsAndTTL(t *testing.T) {
+	var requests atomic.Int32
+	server := newCountingServer(t, &requests, 0)
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL), WithCache(CacheConfig{
+		DefaultTTL: time.Minute,
+		TTLs:       map[string]time.Duration{"markets": 20 * time.Millisecond, "/tags": 0},
+	}))
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.GetEventBySlug(ctx, "election", nil); err != nil {
+			t.Fatalf("GetEventBySlug failed: %v", err)
+		}
+	}
+	if requests.Load() != 1 {
+		t.Errorf("requests = %d, want 1", requests.Load())
+	}
+
+	// Per-endpoint TTL expires
+	client.GetMarketByID(ctx, "1", nil)
+	time.Sleep(30 * time.Millisecond)
+	client.GetMarketByID(ctx, "1", nil)
+	if requests.Load() != 3 {
+		t.Errorf("requests = %d, want 3 after TTL expiry", requests.Load())
+	}
+
+	// TTL of 0 disables caching for the family
+	client.GetTagByID(ctx, "1", nil)
+	client.GetTagByID(ctx, "1", nil)
+	if requests.Load() != 5 {
+		t.Errorf("requests = %d, want 5 for uncached /tags", requests.Load())
+	}
+
+	stats := client.CacheStats()
+	if stats.Hits != 2 || stats.Misses != 5 {
+		t.Errorf("stats = %+v, want 2 hits and 5 misses", stats)
+	}
+}
+
+func TestCacheBypassAndInvalidate(t *testing.T) {
+	var requests atomic.Int32
+	server := newCountingServer(t, &requests, 0)
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL), WithCache(CacheConfig{DefaultTTL: time.Minute}))
+	ctx := context.Background()
+
+	client.GetEventBySlug(ctx, "a", nil)
+	client.GetEventBySlug(WithCacheBypass(ctx), "a", nil)
+	if requests.Load() != 2 {
+		t.Errorf("requests = %d, want 2 with bypass", requests.Load())
+	}
+
+	client.GetEventBySlug(ctx, "a", nil)
+	if requests.Load() != 2 {
+		t.Errorf("requests = %d, want 2 (bypass refreshes the cache)", requests.Load())
+	}
+
+	client.InvalidateCache("/events/slug/a")
+	client.GetEventBySlug(ctx, "a", nil)
+	if requests.Load() != 3 {
+		t.Errorf("requests = %d, want 3 after invalidation", requests.Load())
+	}
+
+	client.InvalidateCache("")
+	if stats := client.CacheStats(); stats.Entries != 0 {
+		t.Errorf("entries = %d, want 0 after clearing", stats.Entries)
+	}
+}
+
+func TestCacheLRUEviction(t *testing.T) {
+	var requests atomic.Int32
+	server := newCountingServer(t, &requests, 0)
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL), WithCache(CacheConfig{DefaultTTL: time.Minute, MaxEntries: 2}))
+	ctx := context.Background()
+
+	client.GetEventBySlug(ctx, "a", nil)
+	client.GetEventBySlug(ctx, "b", nil)
+	client.GetEventBySlug(ctx, "a", nil) // a becomes most recently used
+	client.GetEventBySlug(ctx, "c", nil) // evicts b
+	client.GetEventBySlug(ctx, "a", nil)
+	if requests.Load() != 3 {
+		t.Errorf("requests = %d, want 3", requests.Load())
+	}
+
+	client.GetEventBySlug(ctx, "b", nil)
+	if requests.Load() != 4 {
+		t.Errorf("requests = %d, want 4 (b was evicted)", requests.Load())
+	}
+
+	stats := client.CacheStats()
+	if stats.Evictions != 2 || stats.Entries != 2 {
+		t.Errorf("stats = %+v, want 2 evictions and 2 entries", stats)
+	}
+}
+
+func TestCacheSingleflight(t *testing.T) {
+	var requests atomic.Int32
+	server := newCountingServer(t, &requests, 50*time.Millisecond)
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL), WithCache(CacheConfig{DefaultTTL: time.Minute}))
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetMarketByID(ctx, "42", nil); err != nil {
+				t.Errorf("GetMarketByID failed: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if requests.Load() != 1 {
+		t.Errorf("requests = %d, want 1", requests.Load())
+	}
+	if stats := client.CacheStats(); stats.Shared+stats.Hits != 9 {
+		t.Errorf("stats = %+v, want 9 shared or cached requests", stats)
+	}
+}
+
+// waitForWaiters blocks until n callers are waiting on the in-flight load of path
+func waitForWaiters(t *testing.T, c *Client, path string, n int) {
+	t.Helper()
+	for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		c.cache.flights.mu.Lock()
+		call, ok := c.cache.flights.calls[path]
+		waiters := 0
+		if ok {
+			waiters = call.waiters
+		}
+		c.cache.flights.mu.Unlock()
+		if waiters == n {
+			return
+		}
+	}
+	t.Fatalf("timed out waiting for %d waiters on %s", n, path)
+}
+
+func TestCacheSingleflightCancellation(t *testing.T) {
+	var requests atomic.Int32
+	release := make(chan struct{})
+	aborted := make(chan struct{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		select {
+		case <-release:
+			w.Write([]byte(`{"id":"42"}`))
+		case <-r.Context().Done():
+			aborted <- struct{}{}
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	t.Run("LeaderCancels", func(t *testing.T) {
+		requests.Store(0)
+		client := NewClient(nil, WithBaseURL(server.URL), WithCache(CacheConfig{DefaultTTL: time.Minute}))
+
+		leaderCtx, cancel := context.WithCancel(context.Background())
+		leaderErr := make(chan error, 1)
+		go func() {
+			_, err := client.GetMarketByID(leaderCtx, "42", nil)
+			leaderErr <- err
+		}()
+		waitForWaiters(t, client, "/markets/42", 1)
+
+		followerErr := make(chan error, 1)
+		go func() {
+			market, err := client.GetMarketByID(context.Background(), "42", nil)
+			if err == nil && market.ID != "42" {
+				t.Errorf("follower got market %q", market.ID)
+			}
+			followerErr <- err
+		}()
+		waitForWaiters(t, client, "/markets/42", 2)
+
+		cancel()
+		if err := <-leaderErr; !errors.Is(err, context.Canceled) {
+			t.Errorf("leader error = %v, want context.Canceled", err)
+		}
+
+		release <- struct{}{}
+		if err := <-followerErr; err != nil {
+			t.Errorf("follower failed after the leader cancelled: %v", err)
+		}
+		if requests.Load() != 1 {
+			t.Errorf("requests = %d, want 1", requests.Load())
+		}
+		if _, err := client.GetMarketByID(context.Background(), "42", nil); err != nil || requests.Load() != 1 {
+			t.Errorf("shared result was not cached: err=%v requests=%d", err, requests.Load())
+		}
+	})
+
+	t.Run("FollowerDeadline", func(t *testing.T) {
+		client := NewClient(nil, WithBaseURL(server.URL), WithCache(CacheConfig{DefaultTTL: time.Minute}))
+
+		leaderErr := make(chan error, 1)
+		go func() {
+			_, err := client.GetMarketByID(context.Background(), "42", nil)
+			leaderErr <- err
+		}()
+		waitForWaiters(t, client, "/markets/42", 1)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		if _, err := client.GetMarketByID(ctx, "42", nil); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("follower error = %v, want context.DeadlineExceeded", err)
+		}
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("follower waited %v past its deadline", elapsed)
+		}
+
+		release <- struct{}{}
+		if err := <-leaderErr; err != nil {
+			t.Errorf("leader failed: %v", err)
+		}
+	})
+
+	t.Run("AllCallersLeave", func(t *testing.T) {
+		client := NewClient(nil, WithBaseURL(server.URL), WithCache(CacheConfig{DefaultTTL: time.Minute}))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
+		go func() {
+			_, err := client.GetMarketByID(ctx, "42", nil)
+			done <- err
+		}()
+		waitForWaiters(t, client, "/markets/42", 1)
+
+		cancel()
+		if err := <-done; !errors.Is(err, context.Canceled) {
+			t.Errorf("error = %v, want context.Canceled", err)
+		}
+		select {
+		case <-aborted:
+		case <-time.After(time.Second):
+			t.Error("the abandoned load was not cancelled")
+		}
+	})
+}
+
+func TestCacheBodyIsolatedFromInterceptors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"1"}`))
+	}))
+	defer server.Close()
+
+	// Rewrites the first response body in place, as an interceptor is allowed to
+	var calls atomic.Int32
+	scribble := func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) (*Response, error) {
+			resp, err := next(ctx, call)
+			if calls.Add(1) == 1 && resp != nil {
+				copy(resp.Body, `{"id":"X"}`)
+			}
+			return resp, err
+		}
+	}
+
+	client := NewClient(nil, WithBaseURL(server.URL), WithInterceptors(scribble),
+		WithCache(CacheConfig{DefaultTTL: time.Minute}))
+	ctx := context.Background()
+
+	if market, err := client.GetMarketByID(ctx, "1", nil); err != nil || market.ID != "X" {
+		t.Fatalf("first GetMarketByID = %+v, %v", market, err)
+	}
+	market, err := client.GetMarketByID(ctx, "1", nil)
+	if err != nil || market.ID != "1" {
+		t.Errorf("cached GetMarketByID = %+v, %v, want the unmodified cached body", market, err)
+	}
+}
+
+func TestCacheSkipsErrors(t *testing.T) {
+	var requests atomic.Int32
+	server := newCountingServer(t, &requests, 0)
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL), WithCache(CacheConfig{DefaultTTL: time.Minute}))
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetEventBySlug(ctx, "fail", nil); !IsServerError(err) {
+			t.Fatalf("expected server error, got %v", err)
+		}
+	}
+	if requests.Load() != 2 {
+		t.Errorf("requests = %d, want 2 (errors are not cached)", requests.Load())
+	}
+}
@@ -0,0 +1,34 @@
+package polymarketgamma_test
+
+import (
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	polymarketgamma "github.com/ivanzzeth/polymarket-go-gamma-client"
+	"github.com/ivanzzeth/polymarket-go-gamma-client/gammatest"
+)
+
+func init() {
+	polymarketgamma.CassetteTransport = cassetteTransport
+}
+
+// cassetteTransport replays testdata/cassettes/<TestName>.json; a missing cassette fails the test.
+// Run the tests with GAMMA_RECORD=1 and network access to (re-)record the cassettes.
+func cassetteTransport(t *testing.T) http.RoundTripper {
+	t.Helper()
+
+	cassette := filepath.Join("testdata", "cassettes", t.Name()+".json")
+	rec, err := gammatest.NewRecorder(cassette, gammatest.WithMode(gammatest.ModeFromEnv()))
+	if err != nil {
+		t.Fatalf("failed to open cassette %s (record it with %s=1): %v", cassette, gammatest.RecordEnv, err)
+	}
+
+	t.Cleanup(func() {
+		if err := rec.Save(); err != nil {
+			t.Errorf("failed to save cassette: %v", err)
+		}
+	})
+
+	return rec
+}
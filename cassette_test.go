@@ -0,0 +1,16 @@
+package polymarketgamma
+
+import (
+	"net/http"
+	"testing"
+)
+
+// CassetteTransport opens the cassette recorder for a test. It is set by cassette_hook_test.go in the
+// external test package, since gammatest imports this package and can't be imported here.
+var CassetteTransport func(t *testing.T) http.RoundTripper
+
+// newRecordedClient returns a client replaying testdata/cassettes/<TestName>.json
+func newRecordedClient(t *testing.T) *Client {
+	t.Helper()
+	return NewClient(nil, WithTransport(CassetteTransport(t)))
+}
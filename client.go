@@ -2,50 +2,243 @@ package polymarketgamma
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 )
 
+// DefaultUserAgent is the User-Agent header sent when no custom user agent is configured
+const DefaultUserAgent = "polymarket-go-gamma-client"
+
 // Client is a client for the Gamma API (events and markets metadata)
 type Client struct {
-	host       string
-	httpClient *http.Client
+	host        string
+	httpClient  *http.Client
+	transport   http.RoundTripper
+	userAgent   string
+	headers     http.Header
+	timeout     time.Duration
+	retryPolicy RetryPolicy
+
+	rateLimiter      *RateLimiter
+	endpointLimiters map[string]*RateLimiter
+	rateLimitHook    func(endpoint string, wait time.Duration)
+
+	cache     *responseCache
+	diskCache *DiskCache
+
+	drift           *driftDetector
+	partialDecoding bool
+	maxResponseSize int64
+	strictParams    bool
+
+	interceptors []Interceptor
+	handler      Handler
+	tracer       Tracer
+	metrics      []*Metrics
 }
 
-// NewClient creates a new Gamma API client for querying events and market metadata
-func NewClient(httpClient *http.Client) *Client {
-	return &Client{
+// NewClient creates a new Gamma API client for querying events and market metadata.
+// httpClient may be nil, in which case a default http.Client is used.
+// Additional behaviour (base URL, headers, timeouts, transport) is configured through options.
+func NewClient(httpClient *http.Client, opts ...Option) *Client {
+	if httpClient == nil {
+		httpClient = &http.Client{}
+	}
+
+	c := &Client{
 		host:       GammaAPIURL,
 		httpClient: httpClient,
+		userAgent:  DefaultUserAgent,
+		headers:    make(http.Header),
+	}
+
+	for _, opt := range opts {
+		opt(c)
 	}
+
+	transport := c.transport
+	if c.diskCache != nil {
+		base := transport
+		if base == nil {
+			base = c.httpClient.Transport
+		}
+		transport = c.diskCache.wrap(base, c.maxResponseSize)
+	}
+
+	// Never mutate the caller's http.Client (it may be http.DefaultClient)
+	if transport != nil {
+		hc := *c.httpClient
+		hc.Transport = transport
+		c.httpClient = &hc
+	}
+
+	c.handler = c.buildHandler(c.send)
+
+	return c
 }
 
-// doRequest performs an HTTP request to the Gamma API
-func (c *Client) doRequest(ctx context.Context, method, path string) ([]byte, error) {
-	fullURL := c.host + path
+// BaseURL returns the base URL the client sends requests to
+func (c *Client) BaseURL() string {
+	return c.host
+}
 
-	req, err := http.NewRequestWithContext(ctx, method, fullURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+// decode unmarshals a response body into v, reporting schema drift when detection is enabled.
+// Drift is recorded even when decoding fails, so type changes that break decoding are visible too.
+func (c *Client) decode(path string, body []byte, v any) error {
+	err := json.Unmarshal(body, v)
+	if c.drift != nil {
+		c.drift.inspect(path, body, v)
 	}
+	return err
+}
 
-	req.Header.Set("Accept", "application/json")
+// send is the innermost handler: it serves GET requests from the cache when enabled and otherwise
+// performs the request with retries
+func (c *Client) send(ctx context.Context, call *Call) (*Response, error) {
+	if c.cache == nil || call.Method != http.MethodGet {
+		body, attempts, err := c.doWithRetry(ctx, call)
+		return &Response{StatusCode: statusOf(err), Body: body, Attempts: attempts}, err
+	}
 
-	resp, err := c.httpClient.Do(req)
+	body, hit, attempts, err := c.cache.fetch(ctx, call.Path, func(ctx context.Context) ([]byte, int, error) {
+		return c.doWithRetry(ctx, call)
+	})
+	return &Response{StatusCode: statusOf(err), Body: body, Cached: hit, Attempts: attempts}, err
+}
+
+// doWithRetry performs an HTTP request to the Gamma API, retrying according to the client's retry policy.
+// It also returns the number of attempts made.
+func (c *Client) doWithRetry(ctx context.Context, call *Call) ([]byte, int, error) {
+	var attempts int
+	body, err := withRetry(ctx, c.retryPolicy, func() ([]byte, error) {
+		attempts++
+		return c.doAttempt(ctx, call)
+	})
+	return body, attempts, err
+}
+
+// withRetry calls attempt until it succeeds or the policy gives up
+func withRetry[T any](ctx context.Context, policy RetryPolicy, attempt func() (T, error)) (T, error) {
+	maxAttempts := max(policy.MaxAttempts, 1)
+
+	for n := 1; ; n++ {
+		result, err := attempt()
+		if err == nil {
+			return result, nil
+		}
+
+		var zero T
+		if n >= maxAttempts || !policy.shouldRetry(ctx, err) {
+			if n > 1 {
+				return zero, fmt.Errorf("giving up after %d attempts: %w", n, err)
+			}
+			return zero, err
+		}
+
+		wait, ok := policy.delay(n, err)
+		if !ok {
+			return zero, err
+		}
+		if sleepErr := sleepContext(ctx, wait); sleepErr != nil {
+			return zero, fmt.Errorf("retry aborted: %w (last error: %v)", sleepErr, err)
+		}
+	}
+}
+
+// doAttempt performs a single HTTP request to the Gamma API and reads the whole response body
+func (c *Client) doAttempt(ctx context.Context, call *Call) ([]byte, error) {
+	resp, cancel, err := c.openAttempt(ctx, call)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		return nil, err
 	}
+	defer cancel()
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	body, err := c.readBody(resp.Body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response: %w", err)
 	}
 
+	return body, nil
+}
+
+// openAttempt performs a single HTTP request to the Gamma API and returns the response with its body unread.
+// Non-200 responses are consumed and returned as *APIError. On success the caller must close the body
+// and then call cancel to release the per-request timeout.
+func (c *Client) openAttempt(ctx context.Context, call *Call) (*http.Response, context.CancelFunc, error) {
+	if err := c.waitRateLimit(ctx, call.Path); err != nil {
+		return nil, nil, fmt.Errorf("rate limit wait: %w", err)
+	}
+
+	ctx, span := c.startSpan(ctx, "HTTP "+call.Method)
+	span.SetAttributes(Attribute{Key: "http.method", Value: call.Method}, Attribute{Key: "url.path", Value: call.Path})
+
+	resp, cancel, err := c.sendAttempt(ctx, call, span.SpanContext())
+	span.SetAttributes(Attribute{Key: "http.status_code", Value: statusOf(err)})
+	endSpan(span, err)
+
+	return resp, cancel, err
+}
+
+// sendAttempt sends the request for openAttempt, propagating sc as a W3C traceparent header when valid
+func (c *Client) sendAttempt(ctx context.Context, call *Call, sc SpanContext) (*http.Response, context.CancelFunc, error) {
+	method, path := call.Method, call.Path
+
+	cancel := context.CancelFunc(func() {})
+	if c.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+	}
+
+	fullURL := c.host + path
+
+	req, err := http.NewRequestWithContext(ctx, method, fullURL, nil)
+	if err != nil {
+		cancel()
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	for key, values := range c.headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	for key, values := range call.Header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	if sc.IsValid() {
+		req.Header.Set("traceparent", sc.TraceParent())
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		cancel()
+		return nil, nil, fmt.Errorf("request failed: %w", err)
+	}
+
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API error (%d): %s", resp.StatusCode, string(body))
+		defer cancel()
+		defer resp.Body.Close()
+
+		body, err := c.readBody(resp.Body)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read response: %w", err)
+		}
+		return nil, nil, newAPIError(resp, method, path, body)
 	}
 
-	return body, nil
+	return resp, cancel, nil
+}
+
+// readBody reads a response body, enforcing the configured maximum response size
+func (c *Client) readBody(body io.Reader) ([]byte, error) {
+	return io.ReadAll(c.limitBody(body))
 }
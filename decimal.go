@@ -0,0 +1,442 @@
+package polymarketgamma
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal is an exact, arbitrary-precision decimal number used for prices, sizes, liquidity and volume.
+// The zero value is 0. Decimals are immutable: arithmetic methods return new values.
+//
+// Decimal unmarshals from JSON numbers, numeric strings, empty strings and null (the last two decode to 0),
+// and marshals to a JSON string so no precision is lost on the way out.
+type Decimal struct {
+	coef  *big.Int // Unscaled value; nil means zero
+	scale int32    // Number of digits after the decimal point, always >= 0
+}
+
+// NewDecimal returns value × 10^-scale, e.g. NewDecimal(615, 3) is 0.615.
+// A negative scale multiplies by a power of ten.
+func NewDecimal(value int64, scale int32) Decimal {
+	coef := big.NewInt(value)
+	if scale < 0 {
+		coef.Mul(coef, pow10(-scale))
+		scale = 0
+	}
+	return Decimal{coef: coef, scale: scale}
+}
+
+// NewDecimalFromInt returns the integer value as a Decimal
+func NewDecimalFromInt(value int64) Decimal {
+	return NewDecimal(value, 0)
+}
+
+// NewDecimalFromFloat converts f using its shortest decimal representation, so a float64 decoded
+// from "0.615" converts back to exactly 0.615. NaN and infinities convert to zero.
+func NewDecimalFromFloat(f float64) Decimal {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return Decimal{}
+	}
+	d, _ := ParseDecimal(strconv.FormatFloat(f, 'f', -1, 64))
+	return d
+}
+
+// maxDecimalExponent bounds parsed exponents and scales, so untrusted input such as "1e20000000"
+// cannot force the computation of enormous powers of ten
+const maxDecimalExponent = 1000
+
+// ParseDecimal parses a decimal string such as "0.52", "-3", "1.5e-3" or "12345.000001".
+// Leading and trailing whitespace is ignored. Exponents and decimal places are limited to 1000.
+func ParseDecimal(s string) (Decimal, error) {
+	raw := s
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Decimal{}, fmt.Errorf("invalid decimal %q: empty", raw)
+	}
+
+	exp := int64(0)
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.ParseInt(s[i+1:], 10, 32)
+		if errors.Is(err, strconv.ErrRange) || e > maxDecimalExponent || e < -maxDecimalExponent {
+			return Decimal{}, fmt.Errorf("invalid decimal %q: exponent out of range", raw)
+		}
+		if err != nil {
+			return Decimal{}, fmt.Errorf("invalid decimal %q: bad exponent", raw)
+		}
+		exp = e
+		s = s[:i]
+	}
+
+	sign := ""
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		if s[0] == '-' {
+			sign = "-"
+		}
+		s = s[1:]
+	}
+
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	digits := intPart + fracPart
+	if digits == "" || strings.ContainsFunc(digits, func(r rune) bool { return r < '0' || r > '9' }) {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", raw)
+	}
+
+	coef, ok := new(big.Int).SetString(sign+digits, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", raw)
+	}
+
+	scale := int64(len(fracPart)) - exp
+	if scale > maxDecimalExponent {
+		return Decimal{}, fmt.Errorf("invalid decimal %q: more than %d decimal places", raw, maxDecimalExponent)
+	}
+	if scale < 0 {
+		coef.Mul(coef, pow10(int32(-scale)))
+		scale = 0
+	}
+
+	return Decimal{coef: coef, scale: int32(scale)}, nil
+}
+
+// MustParseDecimal is like ParseDecimal but panics on invalid input. Intended for constants and tests.
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (d *Decimal) UnmarshalJSON(b []byte) error {
+	s := strings.TrimSpace(string(b))
+	if s == "null" {
+		*d = Decimal{}
+		return nil
+	}
+	if len(s) >= 2 && s[0] == '"' {
+		if err := json.Unmarshal([]byte(s), &s); err != nil {
+			return err
+		}
+		if strings.TrimSpace(s) == "" {
+			*d = Decimal{}
+			return nil
+		}
+	}
+
+	parsed, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// String returns the decimal in plain notation without trailing zeros, e.g. "0.5" or "-12"
+func (d Decimal) String() string {
+	if d.coef == nil || d.coef.Sign() == 0 {
+		return "0"
+	}
+
+	digits := new(big.Int).Abs(d.coef).String()
+	if d.scale > 0 {
+		if pad := int(d.scale) + 1 - len(digits); pad > 0 {
+			digits = strings.Repeat("0", pad) + digits
+		}
+		point := len(digits) - int(d.scale)
+		digits = strings.TrimRight(digits[:point]+"."+digits[point:], "0")
+		digits = strings.TrimSuffix(digits, ".")
+	}
+
+	if d.coef.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+// StringFixed returns the decimal rounded to places digits after the point, keeping trailing zeros
+func (d Decimal) StringFixed(places int32) string {
+	r := d.Round(places)
+	if places <= 0 {
+		return r.String()
+	}
+
+	coef := r.rescale(places)
+	digits := new(big.Int).Abs(coef).String()
+	if pad := int(places) + 1 - len(digits); pad > 0 {
+		digits = strings.Repeat("0", pad) + digits
+	}
+	point := len(digits) - int(places)
+	digits = digits[:point] + "." + digits[point:]
+
+	if coef.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+// Float64 returns the nearest float64 value
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of d
+func (d Decimal) Sign() int {
+	if d.coef == nil {
+		return 0
+	}
+	return d.coef.Sign()
+}
+
+// IsZero reports whether d is zero
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Neg returns -d
+func (d Decimal) Neg() Decimal {
+	return Decimal{coef: new(big.Int).Neg(d.int()), scale: d.scale}
+}
+
+// Abs returns |d|
+func (d Decimal) Abs() Decimal {
+	return Decimal{coef: new(big.Int).Abs(d.int()), scale: d.scale}
+}
+
+// Add returns d + other
+func (d Decimal) Add(other Decimal) Decimal {
+	scale := max(d.scale, other.scale)
+	return Decimal{coef: new(big.Int).Add(d.rescale(scale), other.rescale(scale)), scale: scale}
+}
+
+// Sub returns d - other
+func (d Decimal) Sub(other Decimal) Decimal {
+	scale := max(d.scale, other.scale)
+	return Decimal{coef: new(big.Int).Sub(d.rescale(scale), other.rescale(scale)), scale: scale}
+}
+
+// Mul returns d × other
+func (d Decimal) Mul(other Decimal) Decimal {
+	return Decimal{coef: new(big.Int).Mul(d.int(), other.int()), scale: d.scale + other.scale}
+}
+
+// Div returns d / other rounded half away from zero to places digits after the point.
+// Division is the only inexact operation, hence the explicit precision. Div panics if other is zero.
+func (d Decimal) Div(other Decimal, places int32) Decimal {
+	if other.IsZero() {
+		panic("polymarketgamma: decimal division by zero")
+	}
+	places = max(places, 0)
+
+	// d/other = (dc × 10^-ds) / (oc × 10^-os); scaled by 10^places to keep the requested digits
+	num := new(big.Int).Mul(d.int(), pow10(places+other.scale))
+	den := new(big.Int).Mul(other.int(), pow10(d.scale))
+	return Decimal{coef: quoRoundHalfAway(num, den), scale: places}
+}
+
+// Cmp compares d and other and returns -1, 0 or +1
+func (d Decimal) Cmp(other Decimal) int {
+	scale := max(d.scale, other.scale)
+	return d.rescale(scale).Cmp(other.rescale(scale))
+}
+
+// Equal reports whether d == other, regardless of trailing zeros
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Cmp(other) == 0
+}
+
+// LessThan reports whether d < other
+func (d Decimal) LessThan(other Decimal) bool {
+	return d.Cmp(other) < 0
+}
+
+// GreaterThan reports whether d > other
+func (d Decimal) GreaterThan(other Decimal) bool {
+	return d.Cmp(other) > 0
+}
+
+// Round rounds d half away from zero to places digits after the point
+func (d Decimal) Round(places int32) Decimal {
+	places = max(places, 0)
+	if places >= d.scale {
+		return d
+	}
+	return Decimal{coef: quoRoundHalfAway(d.int(), pow10(d.scale-places)), scale: places}
+}
+
+// RoundToTick rounds d half away from zero to the nearest multiple of tick, e.g. a market's
+// OrderPriceMinTickSize. A non-positive tick returns d unchanged.
+func (d Decimal) RoundToTick(tick Decimal) Decimal {
+	return d.toTick(tick, quoRoundHalfAway)
+}
+
+// FloorToTick rounds d down to a multiple of tick, e.g. for a bid that must not cross.
+// A non-positive tick returns d unchanged.
+func (d Decimal) FloorToTick(tick Decimal) Decimal {
+	// big.Int.Div is Euclidean division, which floors for the positive tick
+	return d.toTick(tick, func(num, den *big.Int) *big.Int {
+		return new(big.Int).Div(num, den)
+	})
+}
+
+// CeilToTick rounds d up to a multiple of tick, e.g. for an ask that must not cross.
+// A non-positive tick returns d unchanged.
+func (d Decimal) CeilToTick(tick Decimal) Decimal {
+	return d.toTick(tick, func(num, den *big.Int) *big.Int {
+		q, m := new(big.Int).DivMod(num, den, new(big.Int))
+		if m.Sign() != 0 {
+			q.Add(q, big.NewInt(1))
+		}
+		return q
+	})
+}
+
+// toTick divides d by tick with the given integer quotient function and multiplies back
+func (d Decimal) toTick(tick Decimal, quo func(num, den *big.Int) *big.Int) Decimal {
+	if tick.Sign() <= 0 {
+		return d
+	}
+	scale := max(d.scale, tick.scale)
+	steps := quo(d.rescale(scale), tick.rescale(scale))
+	return Decimal{coef: steps.Mul(steps, tick.rescale(scale)), scale: scale}
+}
+
+// int returns the unscaled value, treating the zero value as 0
+func (d Decimal) int() *big.Int {
+	if d.coef == nil {
+		return new(big.Int)
+	}
+	return d.coef
+}
+
+// rescale returns the unscaled value expressed with scale digits after the point (scale >= d.scale)
+func (d Decimal) rescale(scale int32) *big.Int {
+	if scale == d.scale {
+		return d.int()
+	}
+	return new(big.Int).Mul(d.int(), pow10(scale-d.scale))
+}
+
+// pow10 returns 10^n for n >= 0
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// quoRoundHalfAway returns num/den rounded half away from zero
+func quoRoundHalfAway(num, den *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+	if new(big.Int).Abs(new(big.Int).Lsh(r, 1)).Cmp(new(big.Int).Abs(den)) >= 0 {
+		if num.Sign()*den.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+// BestBidDecimal returns BestBid as a Decimal
+func (m *Market) BestBidDecimal() Decimal {
+	return NewDecimalFromFloat(float64(m.BestBid))
+}
+
+// BestAskDecimal returns BestAsk as a Decimal
+func (m *Market) BestAskDecimal() Decimal {
+	return NewDecimalFromFloat(float64(m.BestAsk))
+}
+
+// LastTradePriceDecimal returns LastTradePrice as a Decimal
+func (m *Market) LastTradePriceDecimal() Decimal {
+	return NewDecimalFromFloat(float64(m.LastTradePrice))
+}
+
+// SpreadDecimal returns Spread as a Decimal
+func (m *Market) SpreadDecimal() Decimal {
+	return NewDecimalFromFloat(float64(m.Spread))
+}
+
+// TickSizeDecimal returns OrderPriceMinTickSize as a Decimal
+func (m *Market) TickSizeDecimal() Decimal {
+	return NewDecimalFromFloat(float64(m.OrderPriceMinTickSize))
+}
+
+// RoundToTick rounds price to the market's OrderPriceMinTickSize.
+// Prices are returned unchanged when the market has no tick size.
+func (m *Market) RoundToTick(price Decimal) Decimal {
+	return price.RoundToTick(m.TickSizeDecimal())
+}
+
+// LiquidityDecimal parses the Liquidity string, falling back to LiquidityNum when it is empty
+func (m *Market) LiquidityDecimal() (Decimal, error) {
+	if strings.TrimSpace(string(m.Liquidity)) == "" {
+		return NewDecimalFromFloat(float64(m.LiquidityNum)), nil
+	}
+	return parseDecimalField("liquidity", string(m.Liquidity))
+}
+
+// VolumeDecimal parses the Volume string, falling back to VolumeNum when it is empty
+func (m *Market) VolumeDecimal() (Decimal, error) {
+	if strings.TrimSpace(string(m.Volume)) == "" {
+		return NewDecimalFromFloat(float64(m.VolumeNum)), nil
+	}
+	return parseDecimalField("volume", string(m.Volume))
+}
+
+// FeeDecimal parses the Fee string; an empty fee is zero
+func (m *Market) FeeDecimal() (Decimal, error) {
+	return parseDecimalField("fee", string(m.Fee))
+}
+
+// UMABondDecimal parses the UMABond string; an empty bond is zero
+func (m *Market) UMABondDecimal() (Decimal, error) {
+	return parseDecimalField("umaBond", string(m.UMABond))
+}
+
+// OutcomePricesDecimal parses OutcomePrices exactly, without going through float64
+func (m *Market) OutcomePricesDecimal() ([]Decimal, error) {
+	prices := make([]Decimal, len(m.OutcomePrices))
+	for i, raw := range m.OutcomePrices {
+		price, err := ParseDecimal(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid outcome price %q at index %d: %w", raw, i, err)
+		}
+		prices[i] = price
+	}
+	return prices, nil
+}
+
+// LiquidityDecimal returns Liquidity as a Decimal
+func (e *Event) LiquidityDecimal() Decimal {
+	return NewDecimalFromFloat(float64(e.Liquidity))
+}
+
+// VolumeDecimal returns Volume as a Decimal
+func (e *Event) VolumeDecimal() Decimal {
+	return NewDecimalFromFloat(float64(e.Volume))
+}
+
+// parseDecimalField parses a numeric string field, treating an empty value as zero
+func parseDecimalField(name, raw string) (Decimal, error) {
+	if strings.TrimSpace(raw) == "" {
+		return Decimal{}, nil
+	}
+	d, err := ParseDecimal(raw)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	return d, nil
+}
@@ -0,0 +1,225 @@
+package polymarketgamma
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "0.52", want: "0.52"},
+		{in: "-3", want: "-3"},
+		{in: " 12345.000001 ", want: "12345.000001"},
+		{in: "0.500", want: "0.5"},
+		{in: "1.5e-3", want: "0.0015"},
+		{in: "2E3", want: "2000"},
+		{in: ".25", want: "0.25"},
+		{in: "+7.", want: "7"},
+		{in: "", wantErr: true},
+		{in: "abc", wantErr: true},
+		{in: "1.2.3", wantErr: true},
+		{in: "1e", wantErr: true},
+		{in: "1e1000", want: "1" + strings.Repeat("0", 1000)},
+		{in: "1e-1000", want: "0." + strings.Repeat("0", 999) + "1"},
+		{in: "1e1001", wantErr: true},
+		{in: "1e20000000", wantErr: true},
+		{in: "1e-2000000000", wantErr: true},
+		{in: "1e99999999999", wantErr: true},
+		{in: "0." + strings.Repeat("1", 1001), wantErr: true},
+		{in: "0." + strings.Repeat("1", 1001) + "e2", want: "11." + strings.Repeat("1", 999)},
+	}
+
+	for _, tt := range tests {
+		name := tt.in
+		if len(name) > 20 {
+			name = name[:20] + "..."
+		}
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseDecimal(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseDecimal(%q) = %s, want error", tt.in, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDecimal(%q) failed: %v", tt.in, err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("ParseDecimal(%q) = %s, want %s", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimalUnmarshalJSON(t *testing.T) {
+	var v struct {
+		Number Decimal `json:"number"`
+		String Decimal `json:"string"`
+		Empty  Decimal `json:"empty"`
+		Null   Decimal `json:"null"`
+	}
+	data := `{"number":0.1,"string":"0.2","empty":"","null":null}`
+	if err := json.Unmarshal([]byte(data), &v); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+
+	if got := v.Number.Add(v.String); got.String() != "0.3" {
+		t.Errorf("0.1 + 0.2 = %s, want 0.3", got)
+	}
+	if !v.Empty.IsZero() || !v.Null.IsZero() {
+		t.Errorf("empty = %s, null = %s, want 0", v.Empty, v.Null)
+	}
+
+	if err := json.Unmarshal([]byte(`{"number":"n/a"}`), &v); err == nil {
+		t.Error("expected error for non-numeric string")
+	}
+	for _, data := range []string{`{"number":1e20000000}`, `{"string":"1e-2000000000"}`} {
+		if err := json.Unmarshal([]byte(data), &v); err == nil {
+			t.Errorf("expected exponent error for %s", data)
+		}
+	}
+
+	out, err := json.Marshal(v.String)
+	if err != nil {
+		t.Fatalf("Failed to marshal: %v", err)
+	}
+	if string(out) != `"0.2"` {
+		t.Errorf("MarshalJSON = %s, want \"0.2\"", out)
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	a := MustParseDecimal("0.615")
+	b := MustParseDecimal("0.385")
+
+	if got := a.Add(b); !got.Equal(NewDecimalFromInt(1)) {
+		t.Errorf("Add = %s, want 1", got)
+	}
+	if got := a.Sub(b); got.String() != "0.23" {
+		t.Errorf("Sub = %s, want 0.23", got)
+	}
+	if got := a.Mul(b); got.String() != "0.236775" {
+		t.Errorf("Mul = %s, want 0.236775", got)
+	}
+	if got := NewDecimalFromInt(1).Div(NewDecimalFromInt(3), 4); got.String() != "0.3333" {
+		t.Errorf("Div = %s, want 0.3333", got)
+	}
+	if got := NewDecimalFromInt(-2).Div(NewDecimalFromInt(3), 2); got.String() != "-0.67" {
+		t.Errorf("Div = %s, want -0.67", got)
+	}
+	if got := a.Neg().Abs(); !got.Equal(a) {
+		t.Errorf("Neg().Abs() = %s, want %s", got, a)
+	}
+
+	// Summing many prices stays exact where float64 drifts
+	sum := Decimal{}
+	for range 10 {
+		sum = sum.Add(MustParseDecimal("0.1"))
+	}
+	if !sum.Equal(NewDecimalFromInt(1)) {
+		t.Errorf("sum = %s, want 1", sum)
+	}
+}
+
+func TestDecimalCompare(t *testing.T) {
+	a := MustParseDecimal("0.50")
+	b := NewDecimal(5, 1)
+	c := MustParseDecimal("0.51")
+
+	if !a.Equal(b) || a.Cmp(b) != 0 {
+		t.Errorf("%s should equal %s", a, b)
+	}
+	if !a.LessThan(c) || !c.GreaterThan(a) {
+		t.Errorf("%s should be less than %s", a, c)
+	}
+	if (Decimal{}).Sign() != 0 || c.Neg().Sign() != -1 {
+		t.Error("unexpected Sign")
+	}
+}
+
+func TestDecimalRounding(t *testing.T) {
+	tick := MustParseDecimal("0.01")
+	tests := []struct {
+		price, round, floor, ceil string
+	}{
+		{"0.534", "0.53", "0.53", "0.54"},
+		{"0.535", "0.54", "0.53", "0.54"},
+		{"0.53", "0.53", "0.53", "0.53"},
+		{"0.999", "1", "0.99", "1"},
+	}
+
+	for _, tt := range tests {
+		price := MustParseDecimal(tt.price)
+		if got := price.RoundToTick(tick); got.String() != tt.round {
+			t.Errorf("RoundToTick(%s) = %s, want %s", tt.price, got, tt.round)
+		}
+		if got := price.FloorToTick(tick); got.String() != tt.floor {
+			t.Errorf("FloorToTick(%s) = %s, want %s", tt.price, got, tt.floor)
+		}
+		if got := price.CeilToTick(tick); got.String() != tt.ceil {
+			t.Errorf("CeilToTick(%s) = %s, want %s", tt.price, got, tt.ceil)
+		}
+	}
+
+	if got := MustParseDecimal("-1.25").Round(1); got.String() != "-1.3" {
+		t.Errorf("Round = %s, want -1.3", got)
+	}
+	if got := MustParseDecimal("1.5").StringFixed(3); got != "1.500" {
+		t.Errorf("StringFixed = %s, want 1.500", got)
+	}
+	if got := MustParseDecimal("0.537").RoundToTick(Decimal{}); got.String() != "0.537" {
+		t.Errorf("RoundToTick with zero tick = %s, want 0.537", got)
+	}
+}
+
+func TestMarketDecimalAccessors(t *testing.T) {
+	data := `{
+		"bestBid": 0.615,
+		"bestAsk": 0.625,
+		"orderPriceMinTickSize": 0.001,
+		"liquidity": "12345.678901234567",
+		"volume": "",
+		"volumeNum": 99.5,
+		"fee": "20000000000000000",
+		"outcomePrices": "[\"0.615\", \"0.385\"]"
+	}`
+
+	var market Market
+	if err := json.Unmarshal([]byte(data), &market); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+
+	if got := market.BestAskDecimal().Sub(market.BestBidDecimal()); got.String() != "0.01" {
+		t.Errorf("spread = %s, want 0.01", got)
+	}
+	if got := market.RoundToTick(MustParseDecimal("0.61249")); got.String() != "0.612" {
+		t.Errorf("RoundToTick = %s, want 0.612", got)
+	}
+
+	liquidity, err := market.LiquidityDecimal()
+	if err != nil || liquidity.String() != "12345.678901234567" {
+		t.Errorf("LiquidityDecimal = %s, %v", liquidity, err)
+	}
+	volume, err := market.VolumeDecimal()
+	if err != nil || volume.String() != "99.5" {
+		t.Errorf("VolumeDecimal = %s, %v", volume, err)
+	}
+	fee, err := market.FeeDecimal()
+	if err != nil || fee.String() != "20000000000000000" {
+		t.Errorf("FeeDecimal = %s, %v", fee, err)
+	}
+
+	prices, err := market.OutcomePricesDecimal()
+	if err != nil {
+		t.Fatalf("OutcomePricesDecimal failed: %v", err)
+	}
+	if total := prices[0].Add(prices[1]); !total.Equal(NewDecimalFromInt(1)) {
+		t.Errorf("outcome prices sum = %s, want 1", total)
+	}
+}
@@ -0,0 +1,247 @@
+package polymarketgamma
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrCacheMiss is returned in offline mode when a request has no cached response
+var ErrCacheMiss = errors.New("offline cache miss")
+
+// DiskCacheHeader is set on responses served by DiskCache: "hit", "revalidated" or "miss"
+const DiskCacheHeader = "X-Gamma-Cache"
+
+// DiskCacheConfig configures a persistent on-disk HTTP cache
+type DiskCacheConfig struct {
+	Dir     string        // Directory holding cached responses (created if missing)
+	TTL     time.Duration // Freshness for responses without ETag/Last-Modified validators (0 = always refetch)
+	Offline bool          // Serve only from the cache and fail with ErrCacheMiss otherwise
+}
+
+// DiskCache is an http.RoundTripper that stores GET responses on disk.
+// Responses carrying an ETag or Last-Modified header are revalidated with conditional
+// requests; others are served from disk until their TTL expires.
+type DiskCache struct {
+	cfg     DiskCacheConfig
+	base    http.RoundTripper
+	maxSize int64      // body size limit from WithMaxResponseSize (0 = no limit)
+	mu      sync.Mutex // serializes writes of the same file
+}
+
+type diskCacheEntry struct {
+	URL        string      `json:"url"`
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+	StoredAt   time.Time   `json:"stored_at"`
+}
+
+// NewDiskCache creates a disk cache rooted at cfg.Dir
+func NewDiskCache(cfg DiskCacheConfig) (*DiskCache, error) {
+	if cfg.Dir == "" {
+		return nil, fmt.Errorf("disk cache directory is required")
+	}
+	if err := os.MkdirAll(cfg.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	return &DiskCache{cfg: cfg}, nil
+}
+
+// WithDiskCache routes requests through a persistent disk cache.
+// The cache wraps the transport configured with WithTransport (or the http.Client's transport).
+// Rate limits are applied before the cache, so requests it answers still spend tokens.
+func WithDiskCache(cache *DiskCache) Option {
+	return func(c *Client) {
+		c.diskCache = cache
+	}
+}
+
+// wrap returns a copy of the cache sending network requests through base and
+// refusing to store bodies larger than maxSize
+func (dc *DiskCache) wrap(base http.RoundTripper, maxSize int64) *DiskCache {
+	return &DiskCache{cfg: dc.cfg, base: base, maxSize: maxSize}
+}
+
+// RoundTrip implements http.RoundTripper
+func (dc *DiskCache) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return dc.transport().RoundTrip(req)
+	}
+
+	path := dc.path(req)
+	entry, _ := dc.load(path)
+
+	if dc.cfg.Offline {
+		if entry == nil {
+			return nil, fmt.Errorf("%w: %s", ErrCacheMiss, req.URL)
+		}
+		return entry.response(req, "hit"), nil
+	}
+
+	validator := entry != nil && (entry.Header.Get("ETag") != "" || entry.Header.Get("Last-Modified") != "")
+
+	if entry != nil && !validator && dc.cfg.TTL > 0 && time.Since(entry.StoredAt) < dc.cfg.TTL {
+		return entry.response(req, "hit"), nil
+	}
+
+	outReq := req
+	if validator {
+		outReq = req.Clone(req.Context())
+		if etag := entry.Header.Get("ETag"); etag != "" {
+			outReq.Header.Set("If-None-Match", etag)
+		}
+		if lastModified := entry.Header.Get("Last-Modified"); lastModified != "" {
+			outReq.Header.Set("If-Modified-Since", lastModified)
+		}
+	}
+
+	resp, err := dc.transport().RoundTrip(outReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if validator && resp.StatusCode == http.StatusNotModified {
+		resp.Body.Close()
+		entry.StoredAt = time.Now()
+		for key, values := range resp.Header {
+			entry.Header[key] = values
+		}
+		dc.store(path, entry)
+		return entry.response(req, "revalidated"), nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	body, err := dc.readBody(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	fresh := &diskCacheEntry{
+		URL:        req.URL.String(),
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		Body:       body,
+		StoredAt:   time.Now(),
+	}
+	dc.store(path, fresh)
+
+	return fresh.response(req, "miss"), nil
+}
+
+// readBody reads a response body, failing with ErrResponseTooLarge past the configured maximum size
+func (dc *DiskCache) readBody(body io.Reader) ([]byte, error) {
+	if dc.maxSize <= 0 {
+		return io.ReadAll(body)
+	}
+	// Read one byte past the limit to tell "exactly at the limit" from "over it"
+	data, err := io.ReadAll(io.LimitReader(body, dc.maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > dc.maxSize {
+		return nil, fmt.Errorf("%w: more than %d bytes", ErrResponseTooLarge, dc.maxSize)
+	}
+	return data, nil
+}
+
+// Clear removes every cached response
+func (dc *DiskCache) Clear() error {
+	entries, err := os.ReadDir(dc.cfg.Dir)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if filepath.Ext(e.Name()) == ".json" {
+			if err := os.Remove(filepath.Join(dc.cfg.Dir, e.Name())); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (dc *DiskCache) transport() http.RoundTripper {
+	if dc.base != nil {
+		return dc.base
+	}
+	return http.DefaultTransport
+}
+
+// path returns the cache file for a request, keyed by method and full URL
+func (dc *DiskCache) path(req *http.Request) string {
+	sum := sha256.Sum256([]byte(req.Method + " " + req.URL.String()))
+	return filepath.Join(dc.cfg.Dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (dc *DiskCache) load(path string) (*diskCacheEntry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var entry diskCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, err
+	}
+	if entry.Header == nil {
+		entry.Header = make(http.Header)
+	}
+	return &entry, nil
+}
+
+// store writes the entry atomically; failures only cost a future cache miss
+func (dc *DiskCache) store(path string, entry *diskCacheEntry) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+
+	tmp, err := os.CreateTemp(dc.cfg.Dir, ".tmp-*")
+	if err != nil {
+		return
+	}
+	_, writeErr := tmp.Write(data)
+	closeErr := tmp.Close()
+	if writeErr != nil || closeErr != nil {
+		os.Remove(tmp.Name())
+		return
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+func (e *diskCacheEntry) response(req *http.Request, status string) *http.Response {
+	header := e.Header.Clone()
+	header.Set(DiskCacheHeader, status)
+	header.Set("Content-Length", strconv.Itoa(len(e.Body)))
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode)),
+		StatusCode:    e.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,152 @@
+package polymarketgamma
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDiskCacheETagRevalidation(t *testing.T) {
+	var requests, notModified atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("ETag", `"v1"`)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Write([]byte(`[{"id":"1","title":"cached"}]`))
+	}))
+	defer server.Close()
+
+	cache, err := NewDiskCache(DiskCacheConfig{Dir: t.TempDir()})
+	if err != nil {
+		t.Fatalf("NewDiskCache failed: %v", err)
+	}
+	client := NewClient(nil, WithBaseURL(server.URL), WithDiskCache(cache))
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		events, err := client.GetEvents(ctx, &GetEventsParams{Limit: 1})
+		if err != nil {
+			t.Fatalf("GetEvents failed: %v", err)
+		}
+		if len(events) != 1 || events[0].Title != "cached" {
+			t.Fatalf("unexpected events: %+v", events)
+		}
+	}
+
+	if requests.Load() != 3 || notModified.Load() != 2 {
+		t.Errorf("requests = %d, 304s = %d; want 3 and 2", requests.Load(), notModified.Load())
+	}
+}
+
+func TestDiskCacheTTLFallbackAndOffline(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Write([]byte(`{"id":"1","slug":"s"}`))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	cache, err := NewDiskCache(DiskCacheConfig{Dir: dir, TTL: time.Hour})
+	if err != nil {
+		t.Fatalf("NewDiskCache failed: %v", err)
+	}
+	client := NewClient(nil, WithBaseURL(server.URL), WithDiskCache(cache))
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetMarketBySlug(ctx, "s", nil); err != nil {
+			t.Fatalf("GetMarketBySlug failed: %v", err)
+		}
+	}
+	if requests.Load() != 1 {
+		t.Errorf("requests = %d, want 1 within TTL", requests.Load())
+	}
+
+	// A new client in offline mode reads the same directory and never touches the network
+	offline, err := NewDiskCache(DiskCacheConfig{Dir: dir, Offline: true})
+	if err != nil {
+		t.Fatalf("NewDiskCache failed: %v", err)
+	}
+	offlineClient := NewClient(nil, WithBaseURL(server.URL), WithDiskCache(offline), WithRetryPolicy(fastRetryPolicy()))
+
+	market, err := offlineClient.GetMarketBySlug(ctx, "s", nil)
+	if err != nil || market.Slug != "s" {
+		t.Fatalf("offline hit failed: %v %+v", err, market)
+	}
+
+	_, err = offlineClient.GetMarketBySlug(ctx, "other", nil)
+	if !errors.Is(err, ErrCacheMiss) {
+		t.Fatalf("expected ErrCacheMiss, got %v", err)
+	}
+	if requests.Load() != 1 {
+		t.Errorf("offline mode made network requests: %d", requests.Load())
+	}
+
+	if err := cache.Clear(); err != nil {
+		t.Fatalf("Clear failed: %v", err)
+	}
+	if _, err := offlineClient.GetMarketBySlug(ctx, "s", nil); !errors.Is(err, ErrCacheMiss) {
+		t.Errorf("expected miss after Clear, got %v", err)
+	}
+}
+
+func TestDiskCacheSkipsErrors(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	cache, err := NewDiskCache(DiskCacheConfig{Dir: t.TempDir(), TTL: time.Hour})
+	if err != nil {
+		t.Fatalf("NewDiskCache failed: %v", err)
+	}
+	client := NewClient(nil, WithBaseURL(server.URL), WithDiskCache(cache))
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetMarketBySlug(context.Background(), "missing", nil); !IsNotFound(err) {
+			t.Fatalf("expected not found, got %v", err)
+		}
+	}
+	if requests.Load() != 2 {
+		t.Errorf("requests = %d, want 2 (errors are not cached)", requests.Load())
+	}
+}
+
+func TestDiskCacheMaxResponseSize(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"1","slug":"` + strings.Repeat("x", 100) + `"}`))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	cache, err := NewDiskCache(DiskCacheConfig{Dir: dir, TTL: time.Hour})
+	if err != nil {
+		t.Fatalf("NewDiskCache failed: %v", err)
+	}
+
+	client := NewClient(nil, WithBaseURL(server.URL), WithDiskCache(cache), WithMaxResponseSize(64))
+	if _, err := client.GetMarketBySlug(context.Background(), "s", nil); !errors.Is(err, ErrResponseTooLarge) {
+		t.Fatalf("expected ErrResponseTooLarge, got %v", err)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("cache holds %d files, want oversized responses not stored", len(entries))
+	}
+
+	client = NewClient(nil, WithBaseURL(server.URL), WithDiskCache(cache), WithMaxResponseSize(1024))
+	if _, err := client.GetMarketBySlug(context.Background(), "s", nil); err != nil {
+		t.Fatalf("GetMarketBySlug under the limit failed: %v", err)
+	}
+}
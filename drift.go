@@ -0,0 +1,370 @@
+package polymarketgamma
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DriftKind classifies a schema difference between an API response and the Go types
+type DriftKind string
+
+const (
+	DriftUnknownField DriftKind = "unknown_field" // JSON key with no matching struct field
+	DriftTypeMismatch DriftKind = "type_mismatch" // JSON value whose type cannot decode into the struct field
+	DriftTypeFlip     DriftKind = "type_flip"     // Informational: a Flex field decoded a value of its non-canonical JSON type
+)
+
+// maxDriftExample bounds the raw value kept as an example in a DriftEntry
+const maxDriftExample = 128
+
+// DriftConfig configures schema drift detection
+type DriftConfig struct {
+	// KeepRaw stores each decoded object's original JSON in its Raw field (Market, Event, Series, Tag, Team)
+	KeepRaw bool
+
+	// OnDrift, when set, is called the first time each drift entry is seen
+	OnDrift func(DriftEntry)
+}
+
+// DriftEntry describes one unknown field or type mismatch on a Go type
+type DriftEntry struct {
+	Type     string    // Go type name, e.g. "Market"
+	Field    string    // JSON key
+	Kind     DriftKind // Unknown field or type mismatch
+	JSONType string    // JSON type of the value: object, array, string, number or bool
+	GoType   string    // Go type of the struct field (empty for unknown fields)
+	Example  string    // First raw value seen, truncated
+	Path     string    // Request path of the first response where it was seen
+	Count    int       // Number of occurrences
+}
+
+// DriftReport lists the schema differences seen since drift detection was enabled or last reset
+type DriftReport struct {
+	Entries []DriftEntry // Sorted by Type, Field and Kind
+}
+
+// Empty reports whether no drift was detected
+func (r DriftReport) Empty() bool {
+	return len(r.Entries) == 0
+}
+
+// ByType groups entries by Go type name
+func (r DriftReport) ByType() map[string][]DriftEntry {
+	grouped := make(map[string][]DriftEntry)
+	for _, entry := range r.Entries {
+		grouped[entry.Type] = append(grouped[entry.Type], entry)
+	}
+	return grouped
+}
+
+// WithSchemaDrift enables drift detection: every decoded response is compared against the Go types,
+// recording unknown JSON fields and type mismatches. Read them with Client.DriftReport.
+// Detection decodes each response a second time, so it is meant for monitoring rather than hot paths.
+func WithSchemaDrift(cfg DriftConfig) Option {
+	return func(c *Client) {
+		c.drift = &driftDetector{cfg: cfg, entries: make(map[driftKey]*DriftEntry)}
+	}
+}
+
+// DriftReport returns the schema drift recorded so far. It is empty when drift detection is disabled.
+func (c *Client) DriftReport() DriftReport {
+	if c.drift == nil {
+		return DriftReport{}
+	}
+	return c.drift.report()
+}
+
+// ResetDriftReport clears the recorded schema drift
+func (c *Client) ResetDriftReport() {
+	if c.drift != nil {
+		c.drift.reset()
+	}
+}
+
+type driftKey struct {
+	typ   string
+	field string
+	kind  DriftKind
+}
+
+type driftDetector struct {
+	cfg DriftConfig
+
+	mu      sync.Mutex
+	entries map[driftKey]*DriftEntry
+}
+
+var (
+	jsonUnmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+	rawMessageType      = reflect.TypeFor[json.RawMessage]()
+	structFieldsCache   sync.Map // reflect.Type -> *structFields
+)
+
+// inspect walks body alongside v, the value it was decoded into
+func (d *driftDetector) inspect(path string, body []byte, v any) {
+	rv := reflect.ValueOf(v)
+	d.walk(path, body, rv.Type(), rv)
+}
+
+// walk compares raw against t. v is the decoded value when it is addressable, used to fill Raw fields.
+func (d *driftDetector) walk(path string, raw json.RawMessage, t reflect.Type, v reflect.Value) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+		if v.IsValid() && !v.IsNil() {
+			v = v.Elem()
+		} else {
+			v = reflect.Value{}
+		}
+	}
+
+	if jsonType(raw) == "null" || reflect.PointerTo(t).Implements(jsonUnmarshalerType) {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &object); err != nil {
+			return
+		}
+
+		fields := cachedStructFields(t)
+		for key, value := range object {
+			field, ok := fields.lookup(key)
+			if !ok {
+				d.record(path, DriftEntry{Type: t.Name(), Field: key, Kind: DriftUnknownField, JSONType: jsonType(value)}, value)
+				continue
+			}
+
+			ft := t.FieldByIndex(field.index).Type
+			if !field.quoted && !jsonCompatible(value, ft) {
+				d.record(path, DriftEntry{Type: t.Name(), Field: key, Kind: DriftTypeMismatch, JSONType: jsonType(value), GoType: ft.String()}, value)
+				continue
+			}
+			if want, ok := canonicalJSONType(ft); ok && jsonType(value) != want && jsonType(value) != "null" {
+				d.record(path, DriftEntry{Type: t.Name(), Field: key, Kind: DriftTypeFlip, JSONType: jsonType(value), GoType: ft.String()}, value)
+			}
+
+			var fv reflect.Value
+			if v.IsValid() {
+				fv = v.FieldByIndex(field.index)
+			}
+			d.walk(path, value, ft, fv)
+		}
+
+		if d.cfg.KeepRaw && fields.raw != nil && v.IsValid() && v.CanSet() {
+			v.FieldByIndex(fields.raw).SetBytes(bytes.Clone(raw))
+		}
+
+	case reflect.Slice, reflect.Array:
+		var elems []json.RawMessage
+		if err := json.Unmarshal(raw, &elems); err != nil {
+			return
+		}
+		for i, elem := range elems {
+			var ev reflect.Value
+			if v.IsValid() && i < v.Len() {
+				ev = v.Index(i)
+			}
+			d.walk(path, elem, t.Elem(), ev)
+		}
+
+	case reflect.Map:
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &object); err != nil {
+			return
+		}
+		for _, value := range object {
+			d.walk(path, value, t.Elem(), reflect.Value{})
+		}
+	}
+}
+
+func (d *driftDetector) record(path string, entry DriftEntry, value json.RawMessage) {
+	key := driftKey{typ: entry.Type, field: entry.Field, kind: entry.Kind}
+
+	d.mu.Lock()
+	if existing, ok := d.entries[key]; ok {
+		existing.Count++
+		d.mu.Unlock()
+		return
+	}
+
+	entry.Path = path
+	entry.Example = string(value)
+	if len(entry.Example) > maxDriftExample {
+		entry.Example = entry.Example[:maxDriftExample] + "..."
+	}
+	entry.Count = 1
+	d.entries[key] = &entry
+	d.mu.Unlock()
+
+	if d.cfg.OnDrift != nil {
+		d.cfg.OnDrift(entry)
+	}
+}
+
+func (d *driftDetector) report() DriftReport {
+	d.mu.Lock()
+	entries := make([]DriftEntry, 0, len(d.entries))
+	for _, entry := range d.entries {
+		entries = append(entries, *entry)
+	}
+	d.mu.Unlock()
+
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if a.Field != b.Field {
+			return a.Field < b.Field
+		}
+		return a.Kind < b.Kind
+	})
+	return DriftReport{Entries: entries}
+}
+
+func (d *driftDetector) reset() {
+	d.mu.Lock()
+	d.entries = make(map[driftKey]*DriftEntry)
+	d.mu.Unlock()
+}
+
+// jsonType returns the JSON type of a raw value
+func jsonType(raw json.RawMessage) string {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return "null"
+	}
+	switch raw[0] {
+	case '{':
+		return "object"
+	case '[':
+		return "array"
+	case '"':
+		return "string"
+	case 't', 'f':
+		return "bool"
+	case 'n':
+		return "null"
+	default:
+		return "number"
+	}
+}
+
+// jsonCompatible reports whether encoding/json can decode raw into a value of type t
+func jsonCompatible(raw json.RawMessage, t reflect.Type) bool {
+	kind := jsonType(raw)
+	if kind == "null" {
+		return true
+	}
+
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	// Custom types such as FlexFloat accept several JSON types; ask them directly
+	if reflect.PointerTo(t).Implements(jsonUnmarshalerType) {
+		return reflect.New(t).Interface().(json.Unmarshaler).UnmarshalJSON(raw) == nil
+	}
+
+	switch t.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.Struct, reflect.Map:
+		return kind == "object"
+	case reflect.Slice:
+		return kind == "array" || (kind == "string" && t.Elem().Kind() == reflect.Uint8)
+	case reflect.Array:
+		return kind == "array"
+	case reflect.String:
+		return kind == "string"
+	case reflect.Bool:
+		return kind == "bool"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return kind == "number" && !bytes.ContainsAny(raw, ".eE")
+	case reflect.Float32, reflect.Float64:
+		return kind == "number"
+	default:
+		return true
+	}
+}
+
+// flexType is implemented by the Flex types, which decode several JSON types but have one canonical type
+type flexType interface {
+	canonicalJSONType() string
+}
+
+func (FlexFloat) canonicalJSONType() string  { return "number" }
+func (FlexInt) canonicalJSONType() string    { return "number" }
+func (FlexString) canonicalJSONType() string { return "string" }
+
+var flexTypeType = reflect.TypeFor[flexType]()
+
+// canonicalJSONType returns the JSON type a Flex field is expected to carry
+func canonicalJSONType(t reflect.Type) (string, bool) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if !t.Implements(flexTypeType) {
+		return "", false
+	}
+	return reflect.Zero(t).Interface().(flexType).canonicalJSONType(), true
+}
+
+type structField struct {
+	index  []int
+	quoted bool // ",string" tag option
+}
+
+type structFields struct {
+	byName map[string]structField
+	raw    []int // Index of the Raw json.RawMessage field, if any
+}
+
+// lookup finds the field for a JSON key, falling back to encoding/json's case-insensitive match
+func (f *structFields) lookup(key string) (structField, bool) {
+	if field, ok := f.byName[key]; ok {
+		return field, true
+	}
+	for name, field := range f.byName {
+		if strings.EqualFold(name, key) {
+			return field, true
+		}
+	}
+	return structField{}, false
+}
+
+func cachedStructFields(t reflect.Type) *structFields {
+	if cached, ok := structFieldsCache.Load(t); ok {
+		return cached.(*structFields)
+	}
+
+	fields := &structFields{byName: make(map[string]structField)}
+	for _, f := range reflect.VisibleFields(t) {
+		if !f.IsExported() || f.Anonymous {
+			continue
+		}
+		if f.Name == "Raw" && f.Type == rawMessageType {
+			fields.raw = f.Index
+		}
+
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = f.Name
+		}
+		fields.byName[name] = structField{index: f.Index, quoted: strings.Contains(opts, "string")}
+	}
+
+	cached, _ := structFieldsCache.LoadOrStore(t, fields)
+	return cached.(*structFields)
+}
@@ -0,0 +1,129 @@
+package polymarketgamma
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSchemaDriftReport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/events":
+			w.Write([]byte(`[
+				{"id":"1","liquidity":100,"newEventField":true,"markets":[{"id":"10","question":"Q?","marketFlavor":"spicy"}]},
+				{"id":"2","newEventField":false,"series":[{"id":"s1","competitive":"0.5"}]}
+			]`))
+		case "/markets":
+			w.Write([]byte(`[{"id":"10","competitive":"high"}]`))
+		}
+	}))
+	defer server.Close()
+
+	var seen []DriftEntry
+	client := NewClient(nil, WithBaseURL(server.URL), WithSchemaDrift(DriftConfig{
+		KeepRaw: true,
+		OnDrift: func(entry DriftEntry) { seen = append(seen, entry) },
+	}))
+	ctx := context.Background()
+
+	events, err := client.GetEvents(ctx, nil)
+	if err != nil {
+		t.Fatalf("GetEvents failed: %v", err)
+	}
+
+	// Competitive is numeric on Market; a non-numeric string breaks decoding but is still reported
+	if _, err := client.GetMarkets(ctx, nil); err == nil {
+		t.Error("expected decode error for string competitive")
+	}
+
+	report := client.DriftReport()
+	want := map[driftKey]int{
+		{typ: "Event", field: "newEventField", kind: DriftUnknownField}: 2,
+		{typ: "Market", field: "marketFlavor", kind: DriftUnknownField}: 1,
+		{typ: "Market", field: "competitive", kind: DriftTypeMismatch}:  1,
+		{typ: "Series", field: "competitive", kind: DriftTypeFlip}:      1,
+	}
+	if len(report.Entries) != len(want) {
+		t.Fatalf("report = %+v, want %d entries", report.Entries, len(want))
+	}
+	for _, entry := range report.Entries {
+		count, ok := want[driftKey{typ: entry.Type, field: entry.Field, kind: entry.Kind}]
+		if !ok {
+			t.Errorf("unexpected entry %+v", entry)
+			continue
+		}
+		if entry.Count != count {
+			t.Errorf("%s.%s count = %d, want %d", entry.Type, entry.Field, entry.Count, count)
+		}
+	}
+	if len(seen) != len(want) {
+		t.Errorf("OnDrift called %d times, want %d", len(seen), len(want))
+	}
+
+	mismatch := report.ByType()["Market"]
+	for _, entry := range mismatch {
+		if entry.Kind == DriftTypeMismatch && (entry.JSONType != "string" || entry.GoType != "polymarketgamma.FlexFloat" || entry.Example != `"high"` || entry.Path != "/markets?") {
+			t.Errorf("mismatch entry = %+v", entry)
+		}
+	}
+
+	// Raw JSON is kept on nested objects too
+	if len(events[0].Raw) == 0 || len(events[0].Markets[0].Raw) == 0 || len(events[1].Series[0].Raw) == 0 {
+		t.Error("expected Raw to be populated on events, markets and series")
+	}
+
+	client.ResetDriftReport()
+	if !client.DriftReport().Empty() {
+		t.Error("expected empty report after reset")
+	}
+}
+
+func TestSchemaDriftFlexFlips(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[
+			{"id":"1","competitive":0.9,"volume":"1000","liquidityNum":null},
+			{"id":"2","competitive":"0.8","volume":2500.5,"liquidityNum":""}
+		]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL), WithSchemaDrift(DriftConfig{}))
+	markets, err := client.GetMarkets(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("GetMarkets failed: %v", err)
+	}
+	if markets[1].Competitive != 0.8 || markets[1].Volume != "2500.5" {
+		t.Errorf("flipped values decoded as %v and %q", markets[1].Competitive, markets[1].Volume)
+	}
+
+	got := make(map[string]DriftEntry)
+	for _, entry := range client.DriftReport().Entries {
+		got[entry.Field] = entry
+	}
+	if len(got) != 3 {
+		t.Fatalf("report = %+v, want flips for competitive, volume and liquidityNum", got)
+	}
+	for field, jsonType := range map[string]string{"competitive": "string", "volume": "number", "liquidityNum": "string"} {
+		if entry := got[field]; entry.Kind != DriftTypeFlip || entry.JSONType != jsonType || entry.Type != "Market" {
+			t.Errorf("%s entry = %+v, want a %s type flip", field, entry, jsonType)
+		}
+	}
+}
+
+func TestSchemaDriftDisabled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"id":"1","brandNew":1}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL))
+	tags, err := client.GetTags(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("GetTags failed: %v", err)
+	}
+	if tags[0].Raw != nil || !client.DriftReport().Empty() {
+		t.Error("drift detection should be off by default")
+	}
+}
@@ -0,0 +1,293 @@
+package polymarketgamma
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidParam is returned in strict mode when params hold a value the API does not know
+var ErrInvalidParam = errors.New("invalid parameter")
+
+// OrderField is a field that list endpoints can be ordered by. The Order param of list requests
+// takes a single field or a comma-separated list of fields.
+type OrderField string
+
+const (
+	OrderID                OrderField = "id"
+	OrderSlug              OrderField = "slug"
+	OrderTitle             OrderField = "title"
+	OrderQuestion          OrderField = "question"
+	OrderLabel             OrderField = "label"
+	OrderName              OrderField = "name"
+	OrderLeague            OrderField = "league"
+	OrderVolume            OrderField = "volume"
+	OrderVolumeNum         OrderField = "volumeNum"
+	OrderVolume24hr        OrderField = "volume24hr"
+	OrderVolume1wk         OrderField = "volume1wk"
+	OrderVolume1mo         OrderField = "volume1mo"
+	OrderVolume1yr         OrderField = "volume1yr"
+	OrderLiquidity         OrderField = "liquidity"
+	OrderLiquidityNum      OrderField = "liquidityNum"
+	OrderOpenInterest      OrderField = "openInterest"
+	OrderStartDate         OrderField = "startDate"
+	OrderEndDate           OrderField = "endDate"
+	OrderClosedTime        OrderField = "closedTime"
+	OrderCreatedAt         OrderField = "createdAt"
+	OrderUpdatedAt         OrderField = "updatedAt"
+	OrderCompetitive       OrderField = "competitive"
+	OrderCommentCount      OrderField = "commentCount"
+	OrderFeaturedOrder     OrderField = "featuredOrder"
+	OrderSpread            OrderField = "spread"
+	OrderBestBid           OrderField = "bestBid"
+	OrderBestAsk           OrderField = "bestAsk"
+	OrderLastTradePrice    OrderField = "lastTradePrice"
+	OrderOneDayPriceChange OrderField = "oneDayPriceChange"
+)
+
+var orderFields = map[OrderField]bool{
+	OrderID: true, OrderSlug: true, OrderTitle: true, OrderQuestion: true, OrderLabel: true, OrderName: true,
+	OrderLeague: true, OrderVolume: true, OrderVolumeNum: true, OrderVolume24hr: true, OrderVolume1wk: true,
+	OrderVolume1mo: true, OrderVolume1yr: true, OrderLiquidity: true, OrderLiquidityNum: true,
+	OrderOpenInterest: true, OrderStartDate: true, OrderEndDate: true, OrderClosedTime: true,
+	OrderCreatedAt: true, OrderUpdatedAt: true, OrderCompetitive: true, OrderCommentCount: true,
+	OrderFeaturedOrder: true, OrderSpread: true, OrderBestBid: true, OrderBestAsk: true,
+	OrderLastTradePrice: true, OrderOneDayPriceChange: true,
+}
+
+// IsValid reports whether o is a known field, or a comma-separated list of known fields
+func (o OrderField) IsValid() bool {
+	for _, field := range strings.Split(string(o), ",") {
+		if !orderFields[OrderField(strings.TrimSpace(field))] {
+			return false
+		}
+	}
+	return true
+}
+
+// Recurrence is how often the events of a series repeat
+type Recurrence string
+
+const (
+	RecurrenceHourly  Recurrence = "hourly"
+	RecurrenceDaily   Recurrence = "daily"
+	RecurrenceWeekly  Recurrence = "weekly"
+	RecurrenceMonthly Recurrence = "monthly"
+	RecurrenceAnnual  Recurrence = "annual"
+)
+
+// IsValid reports whether r is a known recurrence
+func (r Recurrence) IsValid() bool {
+	switch r {
+	case RecurrenceHourly, RecurrenceDaily, RecurrenceWeekly, RecurrenceMonthly, RecurrenceAnnual:
+		return true
+	}
+	return false
+}
+
+// UMAResolutionStatus is the state of a market's UMA oracle resolution
+type UMAResolutionStatus string
+
+const (
+	UMAResolutionProposed UMAResolutionStatus = "proposed"
+	UMAResolutionDisputed UMAResolutionStatus = "disputed"
+	UMAResolutionResolved UMAResolutionStatus = "resolved"
+)
+
+// IsValid reports whether s is a known UMA resolution status
+func (s UMAResolutionStatus) IsValid() bool {
+	switch s {
+	case UMAResolutionProposed, UMAResolutionDisputed, UMAResolutionResolved:
+		return true
+	}
+	return false
+}
+
+// EventsStatus is the event status filter for search
+type EventsStatus string
+
+const (
+	EventsStatusActive EventsStatus = "active"
+	EventsStatusClosed EventsStatus = "closed"
+	EventsStatusAll    EventsStatus = "all"
+)
+
+// IsValid reports whether s is a known events status
+func (s EventsStatus) IsValid() bool {
+	switch s {
+	case EventsStatusActive, EventsStatusClosed, EventsStatusAll:
+		return true
+	}
+	return false
+}
+
+// SportsMarketType is the kind of bet a sports market offers
+type SportsMarketType string
+
+const (
+	SportsMarketMoneyline SportsMarketType = "moneyline"
+	SportsMarketSpreads   SportsMarketType = "spreads"
+	SportsMarketTotals    SportsMarketType = "totals"
+)
+
+// IsValid reports whether t is a known sports market type
+func (t SportsMarketType) IsValid() bool {
+	switch t {
+	case SportsMarketMoneyline, SportsMarketSpreads, SportsMarketTotals:
+		return true
+	}
+	return false
+}
+
+// MarketType distinguishes binary/categorical markets from scalar ones
+type MarketType string
+
+const (
+	MarketTypeNormal MarketType = "normal"
+	MarketTypeScalar MarketType = "scalar"
+)
+
+// IsValid reports whether t is a known market type
+func (t MarketType) IsValid() bool {
+	switch t {
+	case MarketTypeNormal, MarketTypeScalar:
+		return true
+	}
+	return false
+}
+
+// FormatType is how a scalar market's bounds are formatted
+type FormatType string
+
+const (
+	FormatTypeNumber  FormatType = "number"
+	FormatTypeDecimal FormatType = "decimal"
+	FormatTypeDate    FormatType = "date"
+)
+
+// IsValid reports whether t is a known format type
+func (t FormatType) IsValid() bool {
+	switch t {
+	case FormatTypeNumber, FormatTypeDecimal, FormatTypeDate:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether s is a known tag status
+func (s TagStatus) IsValid() bool {
+	switch s {
+	case TagStatusActive, TagStatusClosed, TagStatusAll:
+		return true
+	}
+	return false
+}
+
+// WithStrictParams makes list and search calls fail with ErrInvalidParam, before any request is sent,
+// when params hold an unknown Order, Sort, Recurrence, UMAResolutionStatus, SportsMarketTypes,
+// EventsStatus or Status value. By default such values are passed through and Gamma silently ignores them.
+func WithStrictParams() Option {
+	return func(c *Client) {
+		c.strictParams = true
+	}
+}
+
+// validator is implemented by params types whose enum values can be checked
+type validator interface {
+	validate() error
+}
+
+// checkParams validates params in strict mode
+func (c *Client) checkParams(params validator) error {
+	if !c.strictParams {
+		return nil
+	}
+	return params.validate()
+}
+
+// enumChecker collects unknown enum values; empty values mean unset and are skipped
+type enumChecker []error
+
+func checkEnum[T ~string](errs *enumChecker, name string, value T, valid func(T) bool) {
+	if value != "" && !valid(value) {
+		*errs = append(*errs, fmt.Errorf("unknown %s %q", name, value))
+	}
+}
+
+func (errs enumChecker) err() error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %w", ErrInvalidParam, errors.Join(errs...))
+}
+
+func (p *GetMarketsParams) validate() error {
+	if p == nil {
+		return nil
+	}
+	var errs enumChecker
+	checkEnum(&errs, "order", p.Order, OrderField.IsValid)
+	checkEnum(&errs, "UMA resolution status", p.UMAResolutionStatus, UMAResolutionStatus.IsValid)
+	for _, t := range p.SportsMarketTypes {
+		checkEnum(&errs, "sports market type", t, SportsMarketType.IsValid)
+	}
+	return errs.err()
+}
+
+func (p *GetEventsParams) validate() error {
+	if p == nil {
+		return nil
+	}
+	var errs enumChecker
+	checkEnum(&errs, "order", p.Order, OrderField.IsValid)
+	checkEnum(&errs, "recurrence", p.Recurrence, Recurrence.IsValid)
+	return errs.err()
+}
+
+func (p *GetSeriesParams) validate() error {
+	if p == nil {
+		return nil
+	}
+	var errs enumChecker
+	checkEnum(&errs, "order", p.Order, OrderField.IsValid)
+	checkEnum(&errs, "recurrence", p.Recurrence, Recurrence.IsValid)
+	return errs.err()
+}
+
+func (p *GetTagsParams) validate() error {
+	if p == nil {
+		return nil
+	}
+	var errs enumChecker
+	checkEnum(&errs, "order", p.Order, OrderField.IsValid)
+	return errs.err()
+}
+
+func (p *GetTeamsParams) validate() error {
+	if p == nil {
+		return nil
+	}
+	var errs enumChecker
+	checkEnum(&errs, "order", p.Order, OrderField.IsValid)
+	return errs.err()
+}
+
+func (p *GetRelatedTagsParams) validate() error {
+	if p == nil {
+		return nil
+	}
+	var errs enumChecker
+	checkEnum(&errs, "status", p.Status, TagStatus.IsValid)
+	return errs.err()
+}
+
+func (p *SearchParams) validate() error {
+	if p == nil {
+		return nil
+	}
+	var errs enumChecker
+	checkEnum(&errs, "events status", p.EventsStatus, EventsStatus.IsValid)
+	checkEnum(&errs, "sort", p.Sort, OrderField.IsValid)
+	checkEnum(&errs, "recurrence", p.Recurrence, Recurrence.IsValid)
+	return errs.err()
+}
@@ -0,0 +1,120 @@
+package polymarketgamma
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestEnumIsValid(t *testing.T) {
+	tests := []struct {
+		name  string
+		valid bool
+	}{
+		{"order", OrderVolume24hr.IsValid()},
+		{"order list", OrderField("volume24hr,liquidityNum").IsValid()},
+		{"recurrence", RecurrenceWeekly.IsValid()},
+		{"uma status", UMAResolutionProposed.IsValid()},
+		{"events status", EventsStatusActive.IsValid()},
+		{"sports market type", SportsMarketTotals.IsValid()},
+		{"market type", MarketTypeScalar.IsValid()},
+		{"format type", FormatTypeDecimal.IsValid()},
+		{"tag status", TagStatusAll.IsValid()},
+	}
+	for _, tt := range tests {
+		if !tt.valid {
+			t.Errorf("%s: known value reported invalid", tt.name)
+		}
+	}
+
+	invalid := map[string]bool{
+		"order typo":         OrderField("volume24h").IsValid(),
+		"order list typo":    OrderField("volume24hr,liqudity").IsValid(),
+		"empty order":        OrderField("").IsValid(),
+		"recurrence":         Recurrence("fortnightly").IsValid(),
+		"uma status":         UMAResolutionStatus("pending").IsValid(),
+		"events status":      EventsStatus("open").IsValid(),
+		"sports market type": SportsMarketType("parlay").IsValid(),
+		"market type":        MarketType("binary").IsValid(),
+		"format type":        FormatType("percent").IsValid(),
+		"tag status":         TagStatus("archived").IsValid(),
+	}
+	for name, valid := range invalid {
+		if valid {
+			t.Errorf("%s: unknown value reported valid", name)
+		}
+	}
+}
+
+func TestDecodedEnumFields(t *testing.T) {
+	var market Market
+	data := `{"marketType":"scalar","formatType":"number","umaResolutionStatus":"resolved","sportsMarketType":"moneyline"}`
+	if err := json.Unmarshal([]byte(data), &market); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if market.MarketType != MarketTypeScalar || market.FormatType != FormatTypeNumber ||
+		market.UMAResolutionStatus != UMAResolutionResolved || market.SportsMarketType != SportsMarketMoneyline {
+		t.Errorf("market = %+v", market)
+	}
+
+	var series Series
+	if err := json.Unmarshal([]byte(`{"recurrence":"daily"}`), &series); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if series.Recurrence != RecurrenceDaily {
+		t.Errorf("Recurrence = %q, want daily", series.Recurrence)
+	}
+}
+
+func TestStrictParams(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Path == "/public-search" {
+			w.Write([]byte(`{"events":[],"tags":[],"profiles":[]}`))
+			return
+		}
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+	ctx := context.Background()
+
+	strict := NewClient(nil, WithBaseURL(server.URL), WithStrictParams())
+
+	_, err := strict.GetMarkets(ctx, &GetMarketsParams{Order: "volume24h", SportsMarketTypes: []SportsMarketType{"parlay"}})
+	if !errors.Is(err, ErrInvalidParam) {
+		t.Fatalf("GetMarkets error = %v, want ErrInvalidParam", err)
+	}
+	if _, err := strict.GetEvents(ctx, &GetEventsParams{Recurrence: "fortnightly"}); !errors.Is(err, ErrInvalidParam) {
+		t.Errorf("GetEvents error = %v, want ErrInvalidParam", err)
+	}
+	if _, err := strict.Search(ctx, &SearchParams{Q: "x", EventsStatus: "open"}); !errors.Is(err, ErrInvalidParam) {
+		t.Errorf("Search error = %v, want ErrInvalidParam", err)
+	}
+	for _, err := range strict.StreamSeries(ctx, &GetSeriesParams{Order: "nope"}) {
+		if !errors.Is(err, ErrInvalidParam) {
+			t.Errorf("StreamSeries error = %v, want ErrInvalidParam", err)
+		}
+	}
+	if requests.Load() != 0 {
+		t.Fatalf("requests = %d, want none for invalid params", requests.Load())
+	}
+
+	// Known values pass strict mode
+	if _, err := strict.GetMarkets(ctx, &GetMarketsParams{Order: OrderVolume24hr, UMAResolutionStatus: UMAResolutionResolved}); err != nil {
+		t.Errorf("GetMarkets failed: %v", err)
+	}
+	if _, err := strict.GetTags(ctx, nil); err != nil {
+		t.Errorf("GetTags failed: %v", err)
+	}
+
+	// Without strict mode unknown values are sent as-is
+	lenient := NewClient(nil, WithBaseURL(server.URL))
+	if _, err := lenient.GetMarkets(ctx, &GetMarketsParams{Order: "volume24h"}); err != nil {
+		t.Errorf("lenient GetMarkets failed: %v", err)
+	}
+}
@@ -0,0 +1,72 @@
+package polymarketgamma
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// APIError is returned when the Gamma API responds with a non-200 status code
+type APIError struct {
+	StatusCode int         // HTTP status code
+	Method     string      // HTTP method of the request
+	Path       string      // Request path including the query string
+	Header     http.Header // Response headers
+	Body       []byte      // Raw response body
+	Message    string      // Error message parsed from the Gamma error payload, if any
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = strings.TrimSpace(string(e.Body))
+	}
+	return fmt.Sprintf("API error (%d) %s %s: %s", e.StatusCode, e.Method, e.Path, msg)
+}
+
+// newAPIError builds an APIError from a failed response, parsing the Gamma error payload when present
+func newAPIError(resp *http.Response, method, path string, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Method:     method,
+		Path:       path,
+		Header:     resp.Header,
+		Body:       body,
+	}
+
+	// Gamma error payloads look like {"type":"...","error":"..."} or {"message":"..."}
+	var payload struct {
+		Error   string `json:"error"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(body, &payload); err == nil {
+		if payload.Error != "" {
+			apiErr.Message = payload.Error
+		} else {
+			apiErr.Message = payload.Message
+		}
+	}
+
+	return apiErr
+}
+
+// IsNotFound reports whether err is an APIError with status 404
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// IsRateLimited reports whether err is an APIError with status 429
+func IsRateLimited(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusTooManyRequests
+}
+
+// IsServerError reports whether err is an APIError with a 5xx status
+func IsServerError(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode >= 500 && apiErr.StatusCode <= 599
+}
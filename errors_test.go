@@ -0,0 +1,105 @@
+package polymarketgamma
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAPIError(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        int
+		body          string
+		wantMessage   string
+		isNotFound    bool
+		isRateLimited bool
+		isServerError bool
+	}{
+		{
+			name:        "not found with gamma error payload",
+			status:      http.StatusNotFound,
+			body:        `{"type":"not found error","error":"market not found"}`,
+			wantMessage: "market not found",
+			isNotFound:  true,
+		},
+		{
+			name:          "rate limited with message payload",
+			status:        http.StatusTooManyRequests,
+			body:          `{"message":"slow down"}`,
+			wantMessage:   "slow down",
+			isRateLimited: true,
+		},
+		{
+			name:          "server error with html body",
+			status:        http.StatusBadGateway,
+			body:          `<html>bad gateway</html>`,
+			wantMessage:   "",
+			isServerError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Request-Id", "abc")
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := NewClient(nil, WithBaseURL(server.URL))
+			_, err := client.GetMarketBySlug(context.Background(), "missing", nil)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected *APIError, got %T: %v", err, err)
+			}
+			if apiErr.StatusCode != tt.status {
+				t.Errorf("StatusCode = %d, want %d", apiErr.StatusCode, tt.status)
+			}
+			if apiErr.Method != "GET" || apiErr.Path != "/markets/slug/missing" {
+				t.Errorf("Method/Path = %s %s", apiErr.Method, apiErr.Path)
+			}
+			if apiErr.Header.Get("X-Request-Id") != "abc" {
+				t.Errorf("Header not captured: %v", apiErr.Header)
+			}
+			if string(apiErr.Body) != tt.body {
+				t.Errorf("Body = %q, want %q", apiErr.Body, tt.body)
+			}
+			if apiErr.Message != tt.wantMessage {
+				t.Errorf("Message = %q, want %q", apiErr.Message, tt.wantMessage)
+			}
+			if IsNotFound(err) != tt.isNotFound {
+				t.Errorf("IsNotFound = %v, want %v", IsNotFound(err), tt.isNotFound)
+			}
+			if IsRateLimited(err) != tt.isRateLimited {
+				t.Errorf("IsRateLimited = %v, want %v", IsRateLimited(err), tt.isRateLimited)
+			}
+			if IsServerError(err) != tt.isServerError {
+				t.Errorf("IsServerError = %v, want %v", IsServerError(err), tt.isServerError)
+			}
+		})
+	}
+}
+
+func TestAPIErrorWrapped(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL))
+	_, err := client.HealthCheck(context.Background())
+	if !IsServerError(err) {
+		t.Errorf("expected wrapped server error, got %v", err)
+	}
+	if IsNotFound(errors.New("not found")) {
+		t.Error("plain errors must not be reported as not found")
+	}
+}
@@ -2,12 +2,11 @@ package polymarketgamma
 
 import (
 	"context"
-	"net/http"
 	"testing"
 )
 
 func TestGetEvents(t *testing.T) {
-	client := NewClient(http.DefaultClient)
+	client := newRecordedClient(t)
 	ctx := context.Background()
 
 	// Test getting events with nil params (all events)
@@ -36,7 +35,7 @@ func TestGetEvents(t *testing.T) {
 }
 
 func TestGetEventByID(t *testing.T) {
-	client := NewClient(http.DefaultClient)
+	client := newRecordedClient(t)
 	ctx := context.Background()
 
 	// First get some events
@@ -72,7 +71,7 @@ func TestGetEventByID(t *testing.T) {
 }
 
 func TestGetEventByIDWithParams(t *testing.T) {
-	client := NewClient(http.DefaultClient)
+	client := newRecordedClient(t)
 	ctx := context.Background()
 
 	// First get some events
@@ -122,7 +121,7 @@ func TestGetEventByIDWithParams(t *testing.T) {
 }
 
 func TestGetEventBySlug(t *testing.T) {
-	client := NewClient(http.DefaultClient)
+	client := newRecordedClient(t)
 	ctx := context.Background()
 
 	// First get some events
@@ -163,7 +162,7 @@ func TestGetEventBySlug(t *testing.T) {
 }
 
 func TestGetEventBySlugWithParams(t *testing.T) {
-	client := NewClient(http.DefaultClient)
+	client := newRecordedClient(t)
 	ctx := context.Background()
 
 	// First get some events with slugs
@@ -237,7 +236,7 @@ func TestGetEventBySlugWithParams(t *testing.T) {
 }
 
 func TestGetEventBySlugWithIndividualParams(t *testing.T) {
-	client := NewClient(http.DefaultClient)
+	client := newRecordedClient(t)
 	ctx := context.Background()
 
 	// First get some events with slugs
@@ -325,7 +324,7 @@ func TestGetEventBySlugWithIndividualParams(t *testing.T) {
 }
 
 func TestGetEventByIDWithIndividualParams(t *testing.T) {
-	client := NewClient(http.DefaultClient)
+	client := newRecordedClient(t)
 	ctx := context.Background()
 
 	// First get some events
@@ -418,7 +417,7 @@ func TestGetEventByIDWithIndividualParams(t *testing.T) {
 }
 
 func TestGetEventTags(t *testing.T) {
-	client := NewClient(http.DefaultClient)
+	client := newRecordedClient(t)
 	ctx := context.Background()
 
 	// First get some events
@@ -470,7 +469,7 @@ func TestGetEventTags(t *testing.T) {
 }
 
 func TestAllEventsFunctions(t *testing.T) {
-	client := NewClient(http.DefaultClient)
+	client := newRecordedClient(t)
 	ctx := context.Background()
 
 	// Step 1: Get events
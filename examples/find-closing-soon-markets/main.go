@@ -65,7 +65,7 @@ func main() {
 			}
 
 			// Skip low volume markets
-			if market.VolumeNum < minVolume {
+			if market.VolumeNum.Float64() < minVolume {
 				continue
 			}
 
@@ -80,7 +80,7 @@ func main() {
 					Market:                market,
 					TimeUntilClose:        timeUntilClose,
 					HoursRemaining:        hoursRemaining,
-					CurrentPrice:          market.LastTradePrice,
+					CurrentPrice:          market.LastTradePrice.Float64(),
 					PotentialMispricing:   analyzePotentialMispricing(market, hoursRemaining),
 					AutomaticallyResolved: market.AutomaticallyResolved,
 				}
@@ -238,9 +238,9 @@ func printClosingSoonAnalysis(index int, opp *ClosingSoonOpportunity) {
 
 	// Volume & Liquidity
 	fmt.Printf("\n📊 Trading Activity:\n")
-	fmt.Printf("   Total Volume:         $%s\n", formatNumber(market.VolumeNum))
-	fmt.Printf("   24h Volume:           $%s\n", formatNumber(market.Volume24hr))
-	fmt.Printf("   Liquidity:            $%s\n", formatNumber(market.LiquidityNum))
+	fmt.Printf("   Total Volume:         $%s\n", formatNumber(market.VolumeNum.Float64()))
+	fmt.Printf("   24h Volume:           $%s\n", formatNumber(market.Volume24hr.Float64()))
+	fmt.Printf("   Liquidity:            $%s\n", formatNumber(market.LiquidityNum.Float64()))
 	fmt.Printf("   Accepting Orders:     %t\n", market.AcceptingOrders)
 
 	// Fees
@@ -64,11 +64,11 @@ func main() {
 			}
 
 			// Calculate volume to liquidity ratio
-			volumeRatio := market.Volume24hr / market.LiquidityNum
+			volumeRatio := market.Volume24hr.Float64() / market.LiquidityNum.Float64()
 
 			// Check if market meets criteria
-			if market.Volume24hr > minVolume24hr &&
-				market.LiquidityNum < maxLiquidity &&
+			if market.Volume24hr.Float64() > minVolume24hr &&
+				market.LiquidityNum.Float64() < maxLiquidity &&
 				volumeRatio > minVolumeRatio {
 
 				opportunities = append(opportunities, market)
@@ -94,7 +94,7 @@ func main() {
 
 	// Print detailed analysis for each opportunity
 	for i, market := range opportunities {
-		volumeRatio := market.Volume24hr / market.LiquidityNum
+		volumeRatio := market.Volume24hr.Float64() / market.LiquidityNum.Float64()
 
 		fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
 		fmt.Printf("Opportunity #%d\n", i+1)
@@ -109,12 +109,12 @@ func main() {
 
 		// Volume & Liquidity Analysis
 		fmt.Printf("\n💰 Volume & Liquidity Analysis:\n")
-		fmt.Printf("   24h Volume:           $%s\n", formatNumber(market.Volume24hr))
-		fmt.Printf("   Total Liquidity:      $%s\n", formatNumber(market.LiquidityNum))
-		fmt.Printf("   CLOB Liquidity:       $%s\n", formatNumber(market.LiquidityClob))
-		fmt.Printf("   AMM Liquidity:        $%s\n", formatNumber(market.LiquidityAmm))
+		fmt.Printf("   24h Volume:           $%s\n", formatNumber(market.Volume24hr.Float64()))
+		fmt.Printf("   Total Liquidity:      $%s\n", formatNumber(market.LiquidityNum.Float64()))
+		fmt.Printf("   CLOB Liquidity:       $%s\n", formatNumber(market.LiquidityClob.Float64()))
+		fmt.Printf("   AMM Liquidity:        $%s\n", formatNumber(market.LiquidityAmm.Float64()))
 		fmt.Printf("   Volume/Liquidity:     %.2fx ⚠️  (High turnover!)\n", volumeRatio)
-		fmt.Printf("   Total Volume:         $%s\n", formatNumber(market.VolumeNum))
+		fmt.Printf("   Total Volume:         $%s\n", formatNumber(market.VolumeNum.Float64()))
 
 		// Spread Information
 		fmt.Printf("\n📊 Spread & Pricing:\n")
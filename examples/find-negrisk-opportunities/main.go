@@ -67,7 +67,7 @@ func main() {
 			negRiskMarketCount := 0
 
 			for _, market := range event.Markets {
-				totalLiquidity += market.LiquidityNum
+				totalLiquidity += market.LiquidityNum.Float64()
 
 				if market.Closed || !market.AcceptingOrders {
 					allAcceptingOrders = false
@@ -88,7 +88,7 @@ func main() {
 				TotalLiquidity:     totalLiquidity,
 				MarketCount:        len(event.Markets),
 				NegRiskMarketCount: negRiskMarketCount,
-				NegRiskFeeBips:     event.NegRiskFeeBips,
+				NegRiskFeeBips:     event.NegRiskFeeBips.Int(),
 			}
 
 			// Calculate probability sum for arbitrage check
@@ -146,7 +146,7 @@ func calculateProbabilitySum(event *polymarketgamma.Event) float64 {
 	sum := 0.0
 	for _, market := range event.Markets {
 		if market.LastTradePrice > 0 {
-			sum += market.LastTradePrice
+			sum += market.LastTradePrice.Float64()
 		}
 	}
 	return sum
@@ -183,7 +183,7 @@ func printNegRiskAnalysis(index int, opp *NegRiskOpportunity) {
 	// Liquidity
 	fmt.Printf("\n💰 Liquidity:\n")
 	fmt.Printf("   Total Liquidity:      $%s\n", formatNumber(opp.TotalLiquidity))
-	fmt.Printf("   Event 24h Volume:     $%s\n", formatNumber(event.Volume24hr))
+	fmt.Printf("   Event 24h Volume:     $%s\n", formatNumber(event.Volume24hr.Float64()))
 
 	// Probability Analysis
 	fmt.Printf("\n📊 Probability Analysis:\n")
@@ -205,9 +205,9 @@ func printNegRiskAnalysis(index int, opp *NegRiskOpportunity) {
 	for i, market := range event.Markets {
 		fmt.Printf("   %d. %s\n", i+1, truncateString(market.Question, 65))
 		fmt.Printf("      Price:         %.4f (%.2f%% implied probability)\n", market.LastTradePrice, market.LastTradePrice*100)
-		fmt.Printf("      Liquidity:     $%s\n", formatNumber(market.LiquidityNum))
+		fmt.Printf("      Liquidity:     $%s\n", formatNumber(market.LiquidityNum.Float64()))
 		fmt.Printf("      Spread:        %.4f (%.2f%%)\n", market.Spread, market.Spread*100)
-		fmt.Printf("      24h Volume:    $%s\n", formatNumber(market.Volume24hr))
+		fmt.Printf("      24h Volume:    $%s\n", formatNumber(market.Volume24hr.Float64()))
 		fmt.Printf("      NegRisk:       %t\n", market.NegRiskOther)
 		if i < len(event.Markets)-1 {
 			fmt.Println()
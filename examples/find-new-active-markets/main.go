@@ -73,7 +73,7 @@ func main() {
 			}
 
 			// Check liquidity constraint
-			if market.LiquidityNum > maxLiquidity {
+			if market.LiquidityNum.Float64() > maxLiquidity {
 				continue
 			}
 
@@ -84,8 +84,8 @@ func main() {
 				Market:            market,
 				Age:               age,
 				DaysSinceCreation: daysSinceCreation,
-				CurrentLiquidity:  market.LiquidityNum,
-				CurrentVolume:     market.VolumeNum,
+				CurrentLiquidity:  market.LiquidityNum.Float64(),
+				CurrentVolume:     market.VolumeNum.Float64(),
 				OpportunityScore:  calculateOpportunityScore(market, daysSinceCreation),
 			}
 
@@ -243,10 +243,10 @@ func printNewMarketAnalysis(index int, opp *NewMarketOpportunity) {
 		fmt.Printf("\n")
 	}
 
-	fmt.Printf("   - CLOB Liquidity:     $%s\n", formatNumber(market.LiquidityClob))
-	fmt.Printf("   - AMM Liquidity:      $%s\n", formatNumber(market.LiquidityAmm))
+	fmt.Printf("   - CLOB Liquidity:     $%s\n", formatNumber(market.LiquidityClob.Float64()))
+	fmt.Printf("   - AMM Liquidity:      $%s\n", formatNumber(market.LiquidityAmm.Float64()))
 	fmt.Printf("   Total Volume:         $%s\n", formatNumber(opp.CurrentVolume))
-	fmt.Printf("   24h Volume:           $%s", formatNumber(market.Volume24hr))
+	fmt.Printf("   24h Volume:           $%s", formatNumber(market.Volume24hr.Float64()))
 
 	if market.Volume24hr > 1000 {
 		fmt.Printf(" 📈 (Active trading!)\n")
@@ -333,7 +333,7 @@ func printNewMarketAnalysis(index int, opp *NewMarketOpportunity) {
 
 	liquidityToVolume := 0.0
 	if market.VolumeNum > 0 {
-		liquidityToVolume = market.LiquidityNum / market.VolumeNum
+		liquidityToVolume = market.LiquidityNum.Float64() / market.VolumeNum.Float64()
 	}
 
 	if market.Volume24hr > 0 {
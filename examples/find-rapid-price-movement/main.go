@@ -12,7 +12,9 @@ import (
 )
 
 func main() {
-	client := polymarketgamma.NewClient(http.DefaultClient)
+	client := polymarketgamma.NewClient(http.DefaultClient,
+		polymarketgamma.WithRetryPolicy(polymarketgamma.DefaultRetryPolicy()),
+	)
 	ctx := context.Background()
 
 	fmt.Println("🔍 Finding markets with rapid price movements...")
@@ -24,8 +26,7 @@ func main() {
 	minVolume := 5000.0    // Minimum $5k volume to filter out illiquid markets
 	targetCount := 5       // Find 5 markets
 	limit := 100
-	offset := 0
-	maxAttempts := 10
+	maxScanned := 1000 // Scan at most 1000 markets
 
 	var opportunities []*PriceMovementOpportunity
 	closed := false
@@ -33,64 +34,57 @@ func main() {
 	fmt.Printf("\n🔄 Searching markets...\n")
 	fmt.Printf("   Criteria: 24h price change > %.0f%%, Volume > $%.0f\n\n", minPriceChange*100, minVolume)
 
-	for attempt := 0; attempt < maxAttempts && len(opportunities) < targetCount; attempt++ {
-		params := &polymarketgamma.GetMarketsParams{
-			Limit:  limit,
-			Offset: offset,
-			Closed: &closed,
-		}
+	params := &polymarketgamma.GetMarketsParams{
+		Limit:  limit,
+		Closed: &closed,
+	}
 
-		markets, err := client.GetMarkets(ctx, params)
+	scanned := 0
+	for market, err := range client.AllMarkets(ctx, params, polymarketgamma.WithMaxItems(maxScanned)) {
 		if err != nil {
 			log.Fatalf("Failed to fetch markets: %v", err)
 		}
 
-		if len(markets) == 0 {
-			fmt.Printf("   No more markets to fetch (reached end)\n")
-			break
+		scanned++
+		if scanned%limit == 0 {
+			fmt.Printf("   Scanned %d markets...\n", scanned)
+		}
+
+		// Skip markets without sufficient data
+		if market.Volume24hr.Float64() < minVolume {
+			continue
 		}
 
-		fmt.Printf("   Fetched %d markets (offset: %d)...\n", len(markets), offset)
+		// Skip closed markets
+		if market.Closed || !market.AcceptingOrders {
+			continue
+		}
 
-		for _, market := range markets {
-			// Skip markets without sufficient data
-			if market.Volume24hr < minVolume {
-				continue
+		// Check for significant price change
+		absChange := math.Abs(market.OneDayPriceChange.Float64())
+		if absChange >= minPriceChange {
+			opportunity := &PriceMovementOpportunity{
+				Market:         market,
+				PriceChange24h: market.OneDayPriceChange.Float64(),
+				Direction:      "up",
 			}
 
-			// Skip closed markets
-			if market.Closed || !market.AcceptingOrders {
-				continue
+			if market.OneDayPriceChange < 0 {
+				opportunity.Direction = "down"
 			}
 
-			// Check for significant price change
-			absChange := math.Abs(market.OneDayPriceChange)
-			if absChange >= minPriceChange {
-				opportunity := &PriceMovementOpportunity{
-					Market:         market,
-					PriceChange24h: market.OneDayPriceChange,
-					Direction:      "up",
-				}
-
-				if market.OneDayPriceChange < 0 {
-					opportunity.Direction = "down"
-				}
-
-				// Calculate momentum vs mean reversion signals
-				opportunity.MovementType = analyzePriceMovement(market)
-
-				opportunities = append(opportunities, opportunity)
-				fmt.Printf("   ✓ Found #%d: %s (%s %.1f%%, vol: $%.0f)\n",
-					len(opportunities), truncateString(market.Question, 55),
-					opportunity.Direction, absChange*100, market.Volume24hr)
-
-				if len(opportunities) >= targetCount {
-					break
-				}
+			// Calculate momentum vs mean reversion signals
+			opportunity.MovementType = analyzePriceMovement(market)
+
+			opportunities = append(opportunities, opportunity)
+			fmt.Printf("   ✓ Found #%d: %s (%s %.1f%%, vol: $%.0f)\n",
+				len(opportunities), truncateString(market.Question, 55),
+				opportunity.Direction, absChange*100, market.Volume24hr)
+
+			if len(opportunities) >= targetCount {
+				break
 			}
 		}
-
-		offset += limit
 	}
 
 	if len(opportunities) == 0 {
@@ -124,8 +118,8 @@ type PriceMovementOpportunity struct {
 
 func analyzePriceMovement(market *polymarketgamma.Market) string {
 	// Compare 24h change to 1-week change to determine if this is a new trend or continuation
-	oneDayAbs := math.Abs(market.OneDayPriceChange)
-	oneWeekAbs := math.Abs(market.OneWeekPriceChange)
+	oneDayAbs := math.Abs(market.OneDayPriceChange.Float64())
+	oneWeekAbs := math.Abs(market.OneWeekPriceChange.Float64())
 
 	// If 1-day change is much larger than 1-week average, it's likely a sudden move (mean reversion candidate)
 	if oneWeekAbs < oneDayAbs*0.5 {
@@ -175,9 +169,9 @@ func printPriceMovementAnalysis(index int, opp *PriceMovementOpportunity) {
 
 	// Volume & Liquidity
 	fmt.Printf("\n💰 Volume & Liquidity:\n")
-	fmt.Printf("   24h Volume:           $%s\n", formatNumber(market.Volume24hr))
-	fmt.Printf("   Total Volume:         $%s\n", formatNumber(market.VolumeNum))
-	fmt.Printf("   Liquidity:            $%s\n", formatNumber(market.LiquidityNum))
+	fmt.Printf("   24h Volume:           $%s\n", formatNumber(market.Volume24hr.Float64()))
+	fmt.Printf("   Total Volume:         $%s\n", formatNumber(market.VolumeNum.Float64()))
+	fmt.Printf("   Liquidity:            $%s\n", formatNumber(market.LiquidityNum.Float64()))
 	fmt.Printf("   Spread:               %.4f (%.2f%%)\n", market.Spread, market.Spread*100)
 
 	// Order Book Info
@@ -228,11 +222,11 @@ func printPriceMovementAnalysis(index int, opp *PriceMovementOpportunity) {
 		if opp.Direction == "up" {
 			fmt.Printf("   • Consider BUYING to ride the upward momentum\n")
 			fmt.Printf("   • Price up %.1f%% (24h) and %.1f%% (1w) - strong trend\n",
-				math.Abs(opp.PriceChange24h)*100, math.Abs(market.OneWeekPriceChange)*100)
+				math.Abs(opp.PriceChange24h)*100, math.Abs(market.OneWeekPriceChange.Float64())*100)
 		} else {
 			fmt.Printf("   • Consider SELLING to profit from downward momentum\n")
 			fmt.Printf("   • Price down %.1f%% (24h) and %.1f%% (1w) - strong trend\n",
-				math.Abs(opp.PriceChange24h)*100, math.Abs(market.OneWeekPriceChange)*100)
+				math.Abs(opp.PriceChange24h)*100, math.Abs(market.OneWeekPriceChange.Float64())*100)
 		}
 		fmt.Printf("   • Use trailing stop to lock in profits\n")
 		fmt.Printf("   • Watch for trend reversal signals\n")
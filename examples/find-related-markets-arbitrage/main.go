@@ -129,11 +129,11 @@ func analyzeEvent(event *polymarketgamma.Event, minDeviation float64, minLiquidi
 			return nil
 		}
 
-		sum += market.LastTradePrice
-		totalLiquidity += market.LiquidityNum
+		sum += market.LastTradePrice.Float64()
+		totalLiquidity += market.LiquidityNum.Float64()
 
-		if market.LiquidityNum < minMarketLiquidity {
-			minMarketLiquidity = market.LiquidityNum
+		if market.LiquidityNum.Float64() < minMarketLiquidity {
+			minMarketLiquidity = market.LiquidityNum.Float64()
 		}
 
 		if !market.AcceptingOrders {
@@ -137,7 +137,13 @@ func main() {
 		fmt.Printf("\n📋 Market Details:\n")
 		fmt.Printf("   Market Type:      %s\n", market.MarketType)
 		fmt.Printf("   Category:         %s\n", market.Category)
-		fmt.Printf("   Outcomes:         %s\n", market.Outcomes)
+		if outcomes, err := market.OutcomeList(); err == nil {
+			for _, outcome := range outcomes {
+				fmt.Printf("   Outcome:          %s @ %.4f\n", outcome.Name, outcome.Price)
+			}
+		} else {
+			fmt.Printf("   Outcomes:         %s\n", market.Outcomes)
+		}
 		fmt.Printf("   Description:      %s\n", truncateString(market.Description, 100))
 
 		// Dates
@@ -0,0 +1,54 @@
+package polymarketgamma
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+)
+
+// fetch calls a Client method's endpoint and decodes the response into a T
+func fetch[T any](ctx context.Context, c *Client, endpoint string, params any, path string) (T, error) {
+	return traced(ctx, c, endpoint, params, path, func(body []byte) (T, error) {
+		var v T
+		if err := c.decode(path, body, &v); err != nil {
+			c.recordDecodeError(path)
+			var zero T
+			return zero, fmt.Errorf("failed to parse response: %w", err)
+		}
+		return v, nil
+	})
+}
+
+// fetchList calls a list endpoint and decodes the JSON array response, honoring partial decoding
+func fetchList[T any](ctx context.Context, c *Client, endpoint string, params any, path string) ([]T, error) {
+	return traced(ctx, c, endpoint, params, path, func(body []byte) ([]T, error) {
+		items, err := decodeList[T](c, path, body)
+		if err != nil {
+			c.recordDecodeError(path)
+		}
+		return items, err
+	})
+}
+
+// recordDecodeError counts a response that failed to decode in the WithMetrics collectors
+func (c *Client) recordDecodeError(path string) {
+	for _, m := range c.metrics {
+		m.recordDecodeError(metricsEndpoint(path))
+	}
+}
+
+// doRequest performs a GET request to the Gamma API for the named Client method through the interceptor chain.
+// The returned response may be non-nil on error, carrying the status of the failed request.
+func (c *Client) doRequest(ctx context.Context, endpoint string, params any, path string) (*Response, error) {
+	return c.handler(ctx, newCall(endpoint, params, path))
+}
+
+// newCall builds a GET Call. A nil params pointer is passed on as an untyped nil, so interceptors
+// can test Call.Params == nil.
+func newCall(endpoint string, params any, path string) *Call {
+	if rv := reflect.ValueOf(params); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		params = nil
+	}
+	return &Call{Endpoint: endpoint, Params: params, Method: http.MethodGet, Path: path, Header: make(http.Header)}
+}
@@ -0,0 +1,228 @@
+package gammatest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Fault describes how a request is answered instead of (or on top of) the normal response
+type Fault struct {
+	Delay     time.Duration               // Wait before responding; the request context still cancels the wait
+	Status    int                         // Respond with this status instead of serving the route (0 = serve normally)
+	Header    http.Header                 // Extra response headers, e.g. Retry-After
+	HTML      bool                        // With Status, send an HTML error page like a CDN or load balancer would
+	Body      string                      // With Status, the response body (default {"error": "<status text>"})
+	Truncate  int                         // Cut the normal response after this many bytes and drop the connection (-1 = half)
+	DriftFunc func(object map[string]any) // Rewrite every JSON object in the normal response, e.g. to change a field's type
+}
+
+// RateLimited answers with 429 and a Retry-After header. Retry-After is sent in whole seconds, so a
+// positive retryAfter is rounded up (at least 1s); 0 asks for an immediate retry.
+func RateLimited(retryAfter time.Duration) Fault {
+	seconds := int64(0)
+	if retryAfter > 0 {
+		seconds = int64((retryAfter + time.Second - 1) / time.Second)
+	}
+	return Fault{
+		Status: http.StatusTooManyRequests,
+		Header: http.Header{"Retry-After": {strconv.FormatInt(seconds, 10)}},
+	}
+}
+
+// ServerError answers with a JSON error and the given 5xx status
+func ServerError(status int) Fault {
+	return Fault{Status: status}
+}
+
+// CDNErrorPage answers with an HTML error page and the given status, like an edge proxy in front of the API
+func CDNErrorPage(status int) Fault {
+	return Fault{Status: status, HTML: true}
+}
+
+// Slow delays the normal response
+func Slow(delay time.Duration) Fault {
+	return Fault{Delay: delay}
+}
+
+// Truncated sends only the first half of the normal response before closing the connection
+func Truncated() Fault {
+	return Fault{Truncate: -1}
+}
+
+// SchemaDrift serves the normal response with every JSON object passed through drift
+func SchemaDrift(drift func(object map[string]any)) Fault {
+	return Fault{DriftFunc: drift}
+}
+
+// FaultRule schedules a fault for requests to a route
+type FaultRule struct {
+	Route string // Path prefix, e.g. "/markets" or "/events/slug" ("" or "/" matches every route)
+	After int    // Matching calls served normally before the fault starts
+	Times int    // Consecutive matching calls that get the fault (0 = all remaining calls)
+	Fault Fault
+}
+
+type faultRule struct {
+	FaultRule
+	calls int
+}
+
+func (r *faultRule) matches(path string) bool {
+	route := strings.TrimRight(r.Route, "/")
+	return route == "" || path == route || strings.HasPrefix(path, route+"/")
+}
+
+// InjectFault adds a fault rule. When several rules apply to a call, the first one added wins;
+// every matching rule counts the call either way.
+func (s *Server) InjectFault(rule FaultRule) {
+	s.faultMu.Lock()
+	defer s.faultMu.Unlock()
+	s.rules = append(s.rules, &faultRule{FaultRule: rule})
+}
+
+// ClearFaults removes all fault rules
+func (s *Server) ClearFaults() {
+	s.faultMu.Lock()
+	defer s.faultMu.Unlock()
+	s.rules = nil
+}
+
+// Calls returns how many requests were received for a route prefix ("" counts all requests),
+// including the ones answered with a fault
+func (s *Server) Calls(route string) int {
+	s.faultMu.Lock()
+	defer s.faultMu.Unlock()
+
+	rule := faultRule{FaultRule: FaultRule{Route: route}}
+	n := 0
+	for _, path := range s.calls {
+		if rule.matches(path) {
+			n++
+		}
+	}
+	return n
+}
+
+// nextFault records the call and returns the fault it should get, if any
+func (s *Server) nextFault(r *http.Request) *Fault {
+	s.faultMu.Lock()
+	defer s.faultMu.Unlock()
+
+	s.calls = append(s.calls, r.URL.Path)
+
+	var fault *Fault
+	for _, rule := range s.rules {
+		if !rule.matches(r.URL.Path) {
+			continue
+		}
+		rule.calls++
+		active := rule.calls > rule.After && (rule.Times == 0 || rule.calls <= rule.After+rule.Times)
+		if active && fault == nil {
+			fault = &rule.Fault
+		}
+	}
+	return fault
+}
+
+func (s *Server) serveFault(w http.ResponseWriter, r *http.Request, fault *Fault) {
+	if fault.Delay > 0 {
+		select {
+		case <-time.After(fault.Delay):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	for key, values := range fault.Header {
+		w.Header()[key] = values
+	}
+
+	if fault.Status != 0 {
+		writeFaultStatus(w, fault)
+		return
+	}
+
+	rec := httptest.NewRecorder()
+	s.serve(rec, r)
+	body := rec.Body.Bytes()
+
+	if fault.DriftFunc != nil && rec.Code == http.StatusOK {
+		body = drift(body, fault.DriftFunc)
+	}
+
+	for key, values := range rec.Header() {
+		w.Header()[key] = values
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+
+	if fault.Truncate != 0 {
+		n := fault.Truncate
+		if n < 0 || n > len(body) {
+			n = len(body) / 2
+		}
+		// Writing less than Content-Length makes the server drop the connection mid-body
+		body = body[:n]
+	}
+
+	w.WriteHeader(rec.Code)
+	w.Write(body)
+}
+
+func writeFaultStatus(w http.ResponseWriter, fault *Fault) {
+	body := fault.Body
+	switch {
+	case body != "":
+	case fault.HTML:
+		body = fmt.Sprintf("<html>\r\n<head><title>%d %s</title></head>\r\n<body>\r\n<center><h1>%d %s</h1></center>\r\n<hr><center>cloudflare</center>\r\n</body>\r\n</html>\r\n",
+			fault.Status, http.StatusText(fault.Status), fault.Status, http.StatusText(fault.Status))
+	default:
+		data, _ := json.Marshal(map[string]string{"error": http.StatusText(fault.Status)})
+		body = string(data)
+	}
+
+	if fault.HTML {
+		w.Header().Set("Content-Type", "text/html")
+	} else if w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	w.WriteHeader(fault.Status)
+	w.Write([]byte(body))
+}
+
+// drift rewrites every object in a JSON document, leaving non-JSON bodies untouched
+func drift(body []byte, fn func(map[string]any)) []byte {
+	var doc any
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&doc); err != nil {
+		return body
+	}
+
+	var walk func(v any)
+	walk = func(v any) {
+		switch v := v.(type) {
+		case map[string]any:
+			fn(v)
+			for _, child := range v {
+				walk(child)
+			}
+		case []any:
+			for _, child := range v {
+				walk(child)
+			}
+		}
+	}
+	walk(doc)
+
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return body
+	}
+	return data
+}
@@ -0,0 +1,178 @@
+package gammatest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	polymarketgamma "github.com/ivanzzeth/polymarket-go-gamma-client"
+)
+
+func fastRetryPolicy() polymarketgamma.RetryPolicy {
+	policy := polymarketgamma.DefaultRetryPolicy()
+	policy.InitialBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+	policy.Jitter = 0
+	return policy
+}
+
+func TestFaultRetries(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    FaultRule
+		opts    []polymarketgamma.Option
+		calls   int
+		wantErr func(error) bool
+	}{
+		{
+			name:  "RateLimitedThenOK",
+			rule:  FaultRule{Route: "/markets", Times: 2, Fault: RateLimited(0)},
+			calls: 3,
+		},
+		{
+			name:    "RetryAfterTooLong",
+			rule:    FaultRule{Route: "/markets", Fault: RateLimited(time.Hour)},
+			calls:   1,
+			wantErr: polymarketgamma.IsRateLimited,
+		},
+		{
+			name:  "ServerErrorBurst",
+			rule:  FaultRule{Route: "/markets", Times: 3, Fault: ServerError(http.StatusServiceUnavailable)},
+			calls: 4,
+		},
+		{
+			name:    "ServerErrorOutage",
+			rule:    FaultRule{Route: "/markets", Fault: ServerError(http.StatusBadGateway)},
+			calls:   4,
+			wantErr: polymarketgamma.IsServerError,
+		},
+		{
+			name:    "CDNErrorPage",
+			rule:    FaultRule{Fault: CDNErrorPage(http.StatusBadGateway)},
+			calls:   4,
+			wantErr: polymarketgamma.IsServerError,
+		},
+		{
+			name:  "SlowThenOK",
+			rule:  FaultRule{Route: "/markets", Times: 1, Fault: Slow(200 * time.Millisecond)},
+			opts:  []polymarketgamma.Option{polymarketgamma.WithTimeout(50 * time.Millisecond)},
+			calls: 2,
+		},
+		{
+			name:  "TruncatedThenOK",
+			rule:  FaultRule{Route: "/markets", Times: 1, Fault: Truncated()},
+			calls: 2,
+		},
+		{
+			name:  "OtherRouteUnaffected",
+			rule:  FaultRule{Route: "/events", Fault: ServerError(http.StatusInternalServerError)},
+			calls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := NewServer(testDataset())
+			defer server.Close()
+			server.InjectFault(tt.rule)
+
+			client := server.Client(append([]polymarketgamma.Option{polymarketgamma.WithRetryPolicy(fastRetryPolicy())}, tt.opts...)...)
+			markets, err := client.GetMarkets(context.Background(), &polymarketgamma.GetMarketsParams{Limit: 2})
+
+			switch {
+			case tt.wantErr != nil && !tt.wantErr(err):
+				t.Errorf("unexpected error: %v", err)
+			case tt.wantErr == nil && err != nil:
+				t.Errorf("GetMarkets failed: %v", err)
+			case tt.wantErr == nil && len(markets) != 2:
+				t.Errorf("markets = %d, want 2", len(markets))
+			}
+			if got := server.Calls("/markets"); got != tt.calls {
+				t.Errorf("calls = %d, want %d", got, tt.calls)
+			}
+		})
+	}
+}
+
+func TestRateLimitedRetryAfter(t *testing.T) {
+	tests := []struct {
+		retryAfter time.Duration
+		want       string
+	}{
+		{0, "0"},
+		{time.Millisecond, "1"},
+		{500 * time.Millisecond, "1"},
+		{time.Second, "1"},
+		{1500 * time.Millisecond, "2"},
+		{time.Minute, "60"},
+	}
+
+	for _, tt := range tests {
+		if got := RateLimited(tt.retryAfter).Header.Get("Retry-After"); got != tt.want {
+			t.Errorf("RateLimited(%v) Retry-After = %q, want %q", tt.retryAfter, got, tt.want)
+		}
+	}
+}
+
+func TestFaultWithoutRetries(t *testing.T) {
+	server := NewServer(testDataset())
+	defer server.Close()
+	client := server.Client()
+	ctx := context.Background()
+
+	server.InjectFault(FaultRule{Route: "/markets", Times: 1, Fault: Truncated()})
+	if _, err := client.GetMarkets(ctx, nil); err == nil {
+		t.Error("truncated response must fail without retries")
+	}
+
+	server.InjectFault(FaultRule{Route: "/tags", After: 1, Times: 1, Fault: CDNErrorPage(http.StatusServiceUnavailable)})
+	if _, err := client.GetTags(ctx, nil); err != nil {
+		t.Errorf("first call should pass: %v", err)
+	}
+	_, err := client.GetTags(ctx, nil)
+	var apiErr *polymarketgamma.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable || !strings.Contains(string(apiErr.Body), "<html>") {
+		t.Errorf("expected HTML 503 APIError, got %v", err)
+	}
+	if _, err := client.GetTags(ctx, nil); err != nil {
+		t.Errorf("fault should be over after Times calls: %v", err)
+	}
+
+	server.ClearFaults()
+	if _, err := client.GetMarkets(ctx, nil); err != nil {
+		t.Errorf("GetMarkets failed after ClearFaults: %v", err)
+	}
+}
+
+func TestFaultSchemaDrift(t *testing.T) {
+	server := NewServer(testDataset())
+	defer server.Close()
+	client := server.Client()
+	ctx := context.Background()
+
+	// Fields changing type break decoding with a parse error rather than a silent zero value
+	server.InjectFault(FaultRule{Route: "/markets", Times: 1, Fault: SchemaDrift(func(m map[string]any) {
+		if _, ok := m["slug"]; ok {
+			m["active"] = "yes"
+		}
+	})})
+	_, err := client.GetMarkets(ctx, nil)
+	if err == nil || !strings.Contains(err.Error(), "failed to parse response") {
+		t.Errorf("expected parse error, got %v", err)
+	}
+
+	// Renamed fields decode with zero values
+	server.InjectFault(FaultRule{Route: "/events", Times: 1, Fault: SchemaDrift(func(m map[string]any) {
+		if title, ok := m["title"]; ok {
+			delete(m, "title")
+			m["name"] = title
+		}
+	})})
+	events, err := client.GetEvents(ctx, nil)
+	if err != nil || len(events) != 3 || events[0].Title != "" {
+		t.Errorf("GetEvents with renamed field = %v, %v", events, err)
+	}
+}
@@ -0,0 +1,424 @@
+package gammatest
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// item is the JSON object form of a dataset entry, so filters and sorting work on
+// wire field names ("volume24hr", "endDate", ...) exactly like the API does
+type item map[string]any
+
+func toItems[T any](values []T) []item {
+	items := make([]item, 0, len(values))
+	for _, v := range values {
+		data, err := json.Marshal(v)
+		if err != nil {
+			continue
+		}
+		var it item
+		if json.Unmarshal(data, &it) == nil {
+			items = append(items, it)
+		}
+	}
+	return items
+}
+
+// find returns the first item whose field equals value
+func find(items []item, field, value string) item {
+	for _, it := range items {
+		if it.str(field) == value {
+			return it
+		}
+	}
+	return nil
+}
+
+// str returns a field as a string; numbers and bools are formatted
+func (it item) str(field string) string {
+	switch v := it[field].(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return ""
+	}
+}
+
+// num returns a field as a number, accepting numeric strings
+func (it item) num(field string) (float64, bool) {
+	return toNumber(it[field])
+}
+
+func (it item) flag(field string) bool {
+	b, _ := it[field].(bool)
+	return b
+}
+
+func (it item) list(field string) []item {
+	values, _ := it[field].([]any)
+	items := make([]item, 0, len(values))
+	for _, v := range values {
+		if m, ok := v.(map[string]any); ok {
+			items = append(items, m)
+		}
+	}
+	return items
+}
+
+func toNumber(v any) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func toTime(v any) (time.Time, bool) {
+	s, ok := v.(string)
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	return t, err == nil
+}
+
+// filters is a conjunction of item predicates built from query parameters
+type filters []func(item) bool
+
+func (fs filters) match(it item) bool {
+	for _, f := range fs {
+		if !f(it) {
+			return false
+		}
+	}
+	return true
+}
+
+// oneOf keeps items whose field equals one of the param's values
+func (fs *filters) oneOf(q url.Values, param, field string, fold bool) {
+	values := q[param]
+	if len(values) == 0 {
+		return
+	}
+	*fs = append(*fs, func(it item) bool {
+		got := it.str(field)
+		for _, v := range values {
+			if got == v || (fold && strings.EqualFold(got, v)) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// boolean keeps items whose field equals the param parsed as a bool
+func (fs *filters) boolean(q url.Values, param, field string) error {
+	if !q.Has(param) {
+		return nil
+	}
+	want, err := strconv.ParseBool(q.Get(param))
+	if err != nil {
+		return fmt.Errorf("invalid %s: %q", param, q.Get(param))
+	}
+	*fs = append(*fs, func(it item) bool { return it.flag(field) == want })
+	return nil
+}
+
+// numRange keeps items whose numeric field lies within [minParam, maxParam]
+func (fs *filters) numRange(q url.Values, minParam, maxParam, field string) error {
+	for _, param := range []string{minParam, maxParam} {
+		if !q.Has(param) {
+			continue
+		}
+		bound, err := strconv.ParseFloat(q.Get(param), 64)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %q", param, q.Get(param))
+		}
+		isMin := param == minParam
+		*fs = append(*fs, func(it item) bool {
+			v, ok := it.num(field)
+			if !ok {
+				return false
+			}
+			if isMin {
+				return v >= bound
+			}
+			return v <= bound
+		})
+	}
+	return nil
+}
+
+// timeRange keeps items whose date field lies within [minParam, maxParam]
+func (fs *filters) timeRange(q url.Values, minParam, maxParam, field string) error {
+	for _, param := range []string{minParam, maxParam} {
+		if !q.Has(param) {
+			continue
+		}
+		bound, err := time.Parse(time.RFC3339, q.Get(param))
+		if err != nil {
+			return fmt.Errorf("invalid %s: %q", param, q.Get(param))
+		}
+		isMin := param == minParam
+		*fs = append(*fs, func(it item) bool {
+			v, ok := toTime(it[field])
+			if !ok {
+				return false
+			}
+			if isMin {
+				return !v.Before(bound)
+			}
+			return !v.After(bound)
+		})
+	}
+	return nil
+}
+
+// hasTag keeps (or, with exclude, drops) items carrying a tag with an ID in ids
+func (fs *filters) hasTag(ids map[string]bool, exclude bool) {
+	*fs = append(*fs, func(it item) bool {
+		for _, tag := range it.list("tags") {
+			if ids[tag.str("id")] {
+				return !exclude
+			}
+		}
+		return exclude
+	})
+}
+
+func (s *Server) marketFilters(q url.Values) (filters, error) {
+	var fs filters
+	fs.oneOf(q, "id", "id", false)
+	fs.oneOf(q, "slug", "slug", false)
+	fs.oneOf(q, "condition_ids", "conditionId", true)
+	fs.oneOf(q, "market_maker_address", "marketMakerAddress", true)
+	fs.oneOf(q, "question_ids", "questionID", true)
+	fs.oneOf(q, "uma_resolution_status", "umaResolutionStatus", false)
+	fs.oneOf(q, "game_id", "gameId", false)
+	fs.oneOf(q, "sports_market_types", "sportsMarketType", false)
+
+	if tokens := q["clob_token_ids"]; len(tokens) > 0 {
+		fs = append(fs, func(it item) bool {
+			var ids []string
+			json.Unmarshal([]byte(it.str("clobTokenIds")), &ids)
+			for _, id := range ids {
+				if slices.Contains(tokens, id) {
+					return true
+				}
+			}
+			return false
+		})
+	}
+
+	if err := s.tagFilter(&fs, q); err != nil {
+		return nil, err
+	}
+
+	for _, err := range []error{
+		fs.boolean(q, "closed", "closed"),
+		fs.boolean(q, "cyom", "cyom"),
+		fs.numRange(q, "liquidity_num_min", "liquidity_num_max", "liquidityNum"),
+		fs.numRange(q, "volume_num_min", "volume_num_max", "volumeNum"),
+		fs.numRange(q, "rewards_min_size", "", "rewardsMinSize"),
+		fs.timeRange(q, "start_date_min", "start_date_max", "startDate"),
+		fs.timeRange(q, "end_date_min", "end_date_max", "endDate"),
+	} {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return fs, nil
+}
+
+func (s *Server) eventFilters(q url.Values) (filters, error) {
+	var fs filters
+	fs.oneOf(q, "id", "id", false)
+	fs.oneOf(q, "slug", "slug", false)
+
+	if err := s.tagFilter(&fs, q); err != nil {
+		return nil, err
+	}
+	if excluded := q["exclude_tag_id"]; len(excluded) > 0 {
+		ids := make(map[string]bool)
+		for _, id := range excluded {
+			ids[id] = true
+		}
+		fs.hasTag(ids, true)
+	}
+
+	if recurrence := q.Get("recurrence"); recurrence != "" {
+		fs = append(fs, func(it item) bool {
+			for _, series := range it.list("series") {
+				if series.str("recurrence") == recurrence {
+					return true
+				}
+			}
+			return false
+		})
+	}
+
+	for _, err := range []error{
+		fs.boolean(q, "closed", "closed"),
+		fs.boolean(q, "featured", "featured"),
+		fs.boolean(q, "cyom", "cyom"),
+		fs.numRange(q, "liquidity_min", "liquidity_max", "liquidity"),
+		fs.numRange(q, "volume_min", "volume_max", "volume"),
+		fs.timeRange(q, "start_date_min", "start_date_max", "startDate"),
+		fs.timeRange(q, "end_date_min", "end_date_max", "endDate"),
+	} {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return fs, nil
+}
+
+// tagFilter applies tag_id, widened to related tags when related_tags=true
+func (s *Server) tagFilter(fs *filters, q url.Values) error {
+	if !q.Has("tag_id") {
+		return nil
+	}
+	if _, err := strconv.Atoi(q.Get("tag_id")); err != nil {
+		return fmt.Errorf("invalid tag_id: %q", q.Get("tag_id"))
+	}
+	fs.hasTag(s.relatedTagIDs(q.Get("tag_id"), q.Get("related_tags") == "true"), false)
+	return nil
+}
+
+func seriesFilters(q url.Values) (filters, error) {
+	var fs filters
+	fs.oneOf(q, "slug", "slug", false)
+	fs.oneOf(q, "recurrence", "recurrence", false)
+
+	for param, field := range map[string]string{"categories_ids": "id", "categories_labels": "label"} {
+		values := q[param]
+		if len(values) == 0 {
+			continue
+		}
+		fs = append(fs, func(it item) bool {
+			for _, category := range it.list("categories") {
+				if slices.Contains(values, category.str(field)) {
+					return true
+				}
+			}
+			return false
+		})
+	}
+
+	if err := fs.boolean(q, "closed", "closed"); err != nil {
+		return nil, err
+	}
+	return fs, nil
+}
+
+func tagFilters(q url.Values) (filters, error) {
+	var fs filters
+	if err := fs.boolean(q, "is_carousel", "isCarousel"); err != nil {
+		return nil, err
+	}
+	return fs, nil
+}
+
+func teamFilters(q url.Values) (filters, error) {
+	var fs filters
+	fs.oneOf(q, "league", "league", true)
+	fs.oneOf(q, "name", "name", true)
+	fs.oneOf(q, "abbreviation", "abbreviation", true)
+	return fs, nil
+}
+
+// page applies order/ascending, offset and limit. Without limit all matches are returned.
+func page(items []item, q url.Values) ([]item, error) {
+	if err := sortItems(items, q.Get("order"), q.Get("ascending")); err != nil {
+		return nil, err
+	}
+
+	limit, offset := len(items), 0
+	for param, dst := range map[string]*int{"limit": &limit, "offset": &offset} {
+		if !q.Has(param) {
+			continue
+		}
+		n, err := strconv.Atoi(q.Get(param))
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid %s: %q", param, q.Get(param))
+		}
+		*dst = n
+	}
+
+	if offset >= len(items) {
+		return []item{}, nil
+	}
+	items = items[offset:]
+	if limit < len(items) {
+		items = items[:limit]
+	}
+	return items, nil
+}
+
+// sortItems orders items by a comma-separated list of fields, ascending unless ascending=false
+func sortItems(items []item, order, ascending string) error {
+	if order == "" {
+		return nil
+	}
+
+	asc := true
+	if ascending != "" {
+		var err error
+		if asc, err = strconv.ParseBool(ascending); err != nil {
+			return fmt.Errorf("invalid ascending: %q", ascending)
+		}
+	}
+
+	fields := strings.Split(order, ",")
+	slices.SortStableFunc(items, func(a, b item) int {
+		for _, field := range fields {
+			if c := compareValues(a[strings.TrimSpace(field)], b[strings.TrimSpace(field)]); c != 0 {
+				if !asc {
+					return -c
+				}
+				return c
+			}
+		}
+		return 0
+	})
+	return nil
+}
+
+// compareValues compares numbers (including numeric strings such as IDs), RFC 3339
+// timestamps and strings; missing values sort first
+func compareValues(a, b any) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+
+	if x, ok := toNumber(a); ok {
+		if y, ok := toNumber(b); ok {
+			return cmp.Compare(x, y)
+		}
+	}
+	if x, ok := toTime(a); ok {
+		if y, ok := toTime(b); ok {
+			return x.Compare(y)
+		}
+	}
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
@@ -0,0 +1,355 @@
+// Package gammatest provides helpers for testing code built on the Gamma API client
+// without reaching the network.
+package gammatest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrUnrecorded is returned in replay mode for requests missing from the cassette
+var ErrUnrecorded = errors.New("request not recorded in cassette")
+
+// RecordEnv is the environment variable that switches ModeFromEnv to ModeRecord
+const RecordEnv = "GAMMA_RECORD"
+
+// Mode controls whether a Recorder talks to the network
+type Mode int
+
+const (
+	// ModeReplay serves responses from the cassette and fails on unrecorded requests
+	ModeReplay Mode = iota
+	// ModeRecord sends every request to the network and overwrites the cassette
+	ModeRecord
+	// ModeOnce replays an existing cassette and records a new one when it is missing
+	ModeOnce
+)
+
+func (m Mode) String() string {
+	switch m {
+	case ModeReplay:
+		return "replay"
+	case ModeRecord:
+		return "record"
+	case ModeOnce:
+		return "once"
+	default:
+		return "Mode(" + strconv.Itoa(int(m)) + ")"
+	}
+}
+
+// ModeFromEnv returns ModeRecord when GAMMA_RECORD is set to a true value
+// ("1", "true", ...), and ModeReplay otherwise
+func ModeFromEnv() Mode {
+	if record, _ := strconv.ParseBool(os.Getenv(RecordEnv)); record {
+		return ModeRecord
+	}
+	return ModeReplay
+}
+
+// Matching controls how requests are matched against recorded interactions
+type Matching int
+
+const (
+	// MatchExact requires the same method, path and normalized query
+	MatchExact Matching = iota
+	// MatchFuzzy falls back to the recording with the same method and path
+	// sharing the most query parameters when there is no exact match
+	MatchFuzzy
+)
+
+// Interaction is one recorded request and its response
+type Interaction struct {
+	Method string      `json:"method"`
+	Path   string      `json:"path"`
+	Query  string      `json:"query,omitempty"` // Normalized: sorted keys and values, ignored params removed
+	Status int         `json:"status"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body"`
+}
+
+// Cassette is the on-disk format of a recording
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// RecorderOption configures a Recorder
+type RecorderOption func(*Recorder)
+
+// WithMode sets the recorder mode (default ModeReplay)
+func WithMode(mode Mode) RecorderOption {
+	return func(r *Recorder) {
+		r.mode = mode
+	}
+}
+
+// WithMatching sets how replayed requests are matched (default MatchExact)
+func WithMatching(matching Matching) RecorderOption {
+	return func(r *Recorder) {
+		r.matching = matching
+	}
+}
+
+// WithIgnoredParams drops query parameters such as cache busters or timestamps
+// from the request key before recording and matching
+func WithIgnoredParams(names ...string) RecorderOption {
+	return func(r *Recorder) {
+		for _, name := range names {
+			r.ignored[name] = true
+		}
+	}
+}
+
+// WithRealTransport sets the transport used while recording (default http.DefaultTransport)
+func WithRealTransport(transport http.RoundTripper) RecorderOption {
+	return func(r *Recorder) {
+		r.real = transport
+	}
+}
+
+// Recorder is an http.RoundTripper that records real API responses into a cassette
+// file and replays them later, keyed by method, path and normalized query.
+// Plug it into a client with polymarketgamma.WithTransport.
+type Recorder struct {
+	path     string
+	mode     Mode
+	matching Matching
+	ignored  map[string]bool
+	real     http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+	replayed map[string]int // key -> interactions already served, so repeated requests replay in order
+	dirty    bool
+}
+
+// NewRecorder creates a recorder backed by the cassette at path.
+// The cassette is loaded in replay mode and in ModeOnce when it exists.
+func NewRecorder(path string, opts ...RecorderOption) (*Recorder, error) {
+	r := &Recorder{
+		path:     path,
+		ignored:  make(map[string]bool),
+		real:     http.DefaultTransport,
+		replayed: make(map[string]int),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if r.mode == ModeOnce {
+		if _, err := os.Stat(path); err == nil {
+			r.mode = ModeReplay
+		} else {
+			r.mode = ModeRecord
+		}
+	}
+
+	if r.mode == ModeReplay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read cassette: %w", err)
+		}
+		if err := json.Unmarshal(data, &r.cassette); err != nil {
+			return nil, fmt.Errorf("failed to parse cassette %s: %w", path, err)
+		}
+	}
+
+	return r, nil
+}
+
+// Mode returns the effective mode; ModeOnce resolves to ModeReplay or ModeRecord
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Interactions returns a copy of the recorded interactions
+func (r *Recorder) Interactions() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Interaction(nil), r.cassette.Interactions...)
+}
+
+// RoundTrip implements http.RoundTripper
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if r.mode == ModeRecord {
+		return r.record(req)
+	}
+	return r.replay(req)
+}
+
+// Save writes the cassette to disk if anything was recorded
+func (r *Recorder) Save() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.dirty {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create cassette directory: %w", err)
+	}
+	if err := os.WriteFile(r.path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+
+	r.dirty = false
+	return nil
+}
+
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	resp, err := r.real.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	header := resp.Header.Clone()
+	header.Del("Set-Cookie")
+	header.Del("Date")
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  r.normalizeQuery(req.URL.Query()),
+		Status: resp.StatusCode,
+		Header: header,
+		Body:   string(body),
+	})
+	r.dirty = true
+	r.mu.Unlock()
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	query := r.normalizeQuery(req.URL.Query())
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	matches := r.exactMatches(req.Method, req.URL.Path, query)
+	if len(matches) == 0 && r.matching == MatchFuzzy {
+		matches = r.fuzzyMatches(req.Method, req.URL.Path, query)
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("%w: %s %s", ErrUnrecorded, req.Method, requestKey(req.URL.Path, query))
+	}
+
+	// Identical requests replay their recordings in order; the last one repeats
+	key := req.Method + " " + requestKey(req.URL.Path, query)
+	n := r.replayed[key]
+	r.replayed[key] = n + 1
+	if n >= len(matches) {
+		n = len(matches) - 1
+	}
+
+	return matches[n].response(req), nil
+}
+
+func (r *Recorder) exactMatches(method, path, query string) []Interaction {
+	var matches []Interaction
+	for _, it := range r.cassette.Interactions {
+		if it.Method == method && it.Path == path && it.Query == query {
+			matches = append(matches, it)
+		}
+	}
+	return matches
+}
+
+// fuzzyMatches returns the recordings for method and path sharing the most query parameters
+func (r *Recorder) fuzzyMatches(method, path, query string) []Interaction {
+	want := paramSet(query)
+
+	best := -1
+	var matches []Interaction
+	for _, it := range r.cassette.Interactions {
+		if it.Method != method || it.Path != path {
+			continue
+		}
+		score := 0
+		for param := range paramSet(it.Query) {
+			if want[param] {
+				score++
+			}
+		}
+		switch {
+		case score > best:
+			best = score
+			matches = []Interaction{it}
+		case score == best:
+			matches = append(matches, it)
+		}
+	}
+	return matches
+}
+
+// normalizeQuery drops ignored parameters and sorts keys and values
+func (r *Recorder) normalizeQuery(query url.Values) string {
+	for name := range r.ignored {
+		query.Del(name)
+	}
+	for _, values := range query {
+		sort.Strings(values)
+	}
+	return query.Encode()
+}
+
+func paramSet(query string) map[string]bool {
+	set := make(map[string]bool)
+	for _, pair := range strings.Split(query, "&") {
+		if pair != "" {
+			set[pair] = true
+		}
+	}
+	return set
+}
+
+func requestKey(path, query string) string {
+	if query == "" {
+		return path
+	}
+	return path + "?" + query
+}
+
+func (it Interaction) response(req *http.Request) *http.Response {
+	header := it.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Length", strconv.Itoa(len(it.Body)))
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", it.Status, http.StatusText(it.Status)),
+		StatusCode:    it.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(it.Body)),
+		ContentLength: int64(len(it.Body)),
+		Request:       req,
+	}
+}
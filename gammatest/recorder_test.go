@@ -0,0 +1,132 @@
+package gammatest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	polymarketgamma "github.com/ivanzzeth/polymarket-go-gamma-client"
+)
+
+func newMarketServer(t *testing.T) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `[{"id":"%d","question":"%s"}]`, n, r.URL.Query().Get("limit"))
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func TestRecorderRecordAndReplay(t *testing.T) {
+	server, calls := newMarketServer(t)
+	cassette := filepath.Join(t.TempDir(), "cassettes", "markets.json")
+	ctx := context.Background()
+
+	rec, err := NewRecorder(cassette, WithMode(ModeRecord), WithIgnoredParams("_t"))
+	if err != nil {
+		t.Fatalf("NewRecorder failed: %v", err)
+	}
+	client := polymarketgamma.NewClient(nil, polymarketgamma.WithBaseURL(server.URL), polymarketgamma.WithTransport(rec))
+
+	for _, limit := range []int{1, 1, 2} {
+		if _, err := client.GetMarkets(ctx, &polymarketgamma.GetMarketsParams{Limit: limit}); err != nil {
+			t.Fatalf("GetMarkets failed while recording: %v", err)
+		}
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	server.Close()
+
+	rec, err = NewRecorder(cassette, WithMode(ModeOnce))
+	if err != nil {
+		t.Fatalf("NewRecorder failed: %v", err)
+	}
+	if rec.Mode() != ModeReplay {
+		t.Fatalf("ModeOnce with an existing cassette resolved to %v", rec.Mode())
+	}
+	client = polymarketgamma.NewClient(nil, polymarketgamma.WithBaseURL(server.URL), polymarketgamma.WithTransport(rec))
+
+	// Repeated requests replay their recordings in order, then repeat the last one
+	for i, want := range []string{"1", "2", "2"} {
+		markets, err := client.GetMarkets(ctx, &polymarketgamma.GetMarketsParams{Limit: 1})
+		if err != nil {
+			t.Fatalf("replay %d failed: %v", i, err)
+		}
+		if markets[0].ID != want {
+			t.Errorf("replay %d returned market %s, want %s", i, markets[0].ID, want)
+		}
+	}
+
+	markets, err := client.GetMarkets(ctx, &polymarketgamma.GetMarketsParams{Limit: 2})
+	if err != nil || markets[0].ID != "3" {
+		t.Errorf("replay of limit=2 = %v, %v", markets, err)
+	}
+
+	if calls.Load() != 3 {
+		t.Errorf("server calls = %d, want 3", calls.Load())
+	}
+}
+
+func TestRecorderUnrecorded(t *testing.T) {
+	server, _ := newMarketServer(t)
+	cassette := filepath.Join(t.TempDir(), "markets.json")
+
+	rec, _ := NewRecorder(cassette, WithMode(ModeRecord))
+	client := polymarketgamma.NewClient(nil, polymarketgamma.WithBaseURL(server.URL), polymarketgamma.WithTransport(rec))
+	if _, err := client.GetMarkets(context.Background(), &polymarketgamma.GetMarketsParams{Limit: 1, Offset: 0}); err != nil {
+		t.Fatalf("GetMarkets failed while recording: %v", err)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	t.Run("Exact", func(t *testing.T) {
+		rec, _ := NewRecorder(cassette)
+		client := polymarketgamma.NewClient(nil, polymarketgamma.WithBaseURL(server.URL), polymarketgamma.WithTransport(rec))
+		_, err := client.GetMarkets(context.Background(), &polymarketgamma.GetMarketsParams{Limit: 1, Offset: 50})
+		if !errors.Is(err, ErrUnrecorded) {
+			t.Errorf("expected ErrUnrecorded, got %v", err)
+		}
+		if _, err := client.GetEvents(context.Background(), nil); !errors.Is(err, ErrUnrecorded) {
+			t.Errorf("expected ErrUnrecorded for another path, got %v", err)
+		}
+	})
+
+	t.Run("Fuzzy", func(t *testing.T) {
+		rec, _ := NewRecorder(cassette, WithMatching(MatchFuzzy))
+		client := polymarketgamma.NewClient(nil, polymarketgamma.WithBaseURL(server.URL), polymarketgamma.WithTransport(rec))
+		markets, err := client.GetMarkets(context.Background(), &polymarketgamma.GetMarketsParams{Limit: 1, Offset: 50})
+		if err != nil || len(markets) != 1 {
+			t.Errorf("fuzzy replay = %v, %v", markets, err)
+		}
+		if _, err := client.GetEvents(context.Background(), nil); !errors.Is(err, ErrUnrecorded) {
+			t.Errorf("fuzzy matching must still require the same path, got %v", err)
+		}
+	})
+
+	t.Run("MissingCassette", func(t *testing.T) {
+		if _, err := NewRecorder(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+			t.Error("replay mode must fail without a cassette")
+		}
+	})
+}
+
+func TestModeFromEnv(t *testing.T) {
+	t.Setenv(RecordEnv, "1")
+	if ModeFromEnv() != ModeRecord {
+		t.Error("GAMMA_RECORD=1 should select ModeRecord")
+	}
+	t.Setenv(RecordEnv, "")
+	if ModeFromEnv() != ModeReplay {
+		t.Error("unset GAMMA_RECORD should select ModeReplay")
+	}
+}
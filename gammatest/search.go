@@ -0,0 +1,163 @@
+package gammatest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// serveSearch implements /public-search: events, tags and profiles whose text contains q
+func (s *Server) serveSearch(w http.ResponseWriter, q url.Values) {
+	term := strings.ToLower(strings.TrimSpace(q.Get("q")))
+	if term == "" {
+		writeError(w, http.StatusBadRequest, "q is required")
+		return
+	}
+
+	limit, pageNum := DefaultSearchLimitPerType, 1
+	for param, dst := range map[string]*int{"limit_per_type": &limit, "page": &pageNum} {
+		if !q.Has(param) {
+			continue
+		}
+		n, err := strconv.Atoi(q.Get(param))
+		if err != nil || n < 1 {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid %s: %q", param, q.Get(param)))
+			return
+		}
+		*dst = n
+	}
+
+	fs, err := s.searchEventFilters(q)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	allEvents := toItems(s.data.Events)
+	events := []item{}
+	for _, event := range allEvents {
+		if contains(event, term, "title", "slug", "description") && fs.match(event) {
+			if q.Get("keep_closed_markets") == "0" {
+				event["markets"] = openMarkets(event.list("markets"))
+			}
+			events = append(events, event)
+		}
+	}
+	if err := sortItems(events, q.Get("sort"), q.Get("ascending")); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var tags, profiles []item
+	if q.Get("search_tags") != "false" {
+		for _, tag := range toItems(s.data.Tags) {
+			if contains(tag, term, "label", "slug") {
+				tags = append(tags, item{
+					"id":          tag.str("id"),
+					"label":       tag.str("label"),
+					"slug":        tag.str("slug"),
+					"event_count": countTagged(allEvents, tag.str("id")),
+				})
+			}
+		}
+	}
+	if q.Get("search_profiles") != "false" {
+		for _, profile := range toItems(s.data.Profiles) {
+			if contains(profile, term, "name", "pseudonym") {
+				profiles = append(profiles, profile)
+			}
+		}
+	}
+
+	start := (pageNum - 1) * limit
+	writeJSON(w, map[string]any{
+		"events":   window(events, start, limit),
+		"tags":     window(tags, start, limit),
+		"profiles": window(profiles, start, limit),
+		"pagination": map[string]any{
+			"hasMore":      start+limit < len(events),
+			"totalResults": len(events),
+		},
+	})
+}
+
+func (s *Server) searchEventFilters(q url.Values) (filters, error) {
+	var fs filters
+
+	switch status := q.Get("events_status"); status {
+	case "", "all":
+	case "active":
+		fs = append(fs, func(it item) bool { return it.flag("active") && !it.flag("closed") })
+	case "closed":
+		fs = append(fs, func(it item) bool { return it.flag("closed") })
+	default:
+		return nil, fmt.Errorf("invalid events_status: %q", status)
+	}
+
+	if slugs := q["events_tag"]; len(slugs) > 0 {
+		fs = append(fs, func(it item) bool {
+			for _, tag := range it.list("tags") {
+				for _, slug := range slugs {
+					if tag.str("slug") == slug {
+						return true
+					}
+				}
+			}
+			return false
+		})
+	}
+
+	// recurrence and exclude_tag_id behave as on /events
+	events, err := s.eventFilters(url.Values{"recurrence": q["recurrence"], "exclude_tag_id": q["exclude_tag_id"]})
+	if err != nil {
+		return nil, err
+	}
+	return append(fs, events...), nil
+}
+
+// contains reports whether any of the fields contains the lowercase term
+func contains(it item, term string, fields ...string) bool {
+	for _, field := range fields {
+		if strings.Contains(strings.ToLower(it.str(field)), term) {
+			return true
+		}
+	}
+	return false
+}
+
+func countTagged(events []item, tagID string) int {
+	n := 0
+	for _, event := range events {
+		for _, tag := range event.list("tags") {
+			if tag.str("id") == tagID {
+				n++
+				break
+			}
+		}
+	}
+	return n
+}
+
+func openMarkets(markets []item) []item {
+	open := []item{}
+	for _, market := range markets {
+		if !market.flag("closed") {
+			open = append(open, market)
+		}
+	}
+	return open
+}
+
+// window returns items[start:start+n], clamped to the slice
+func window(items []item, start, n int) []item {
+	if start >= len(items) {
+		return []item{}
+	}
+	items = items[start:]
+	if n < len(items) {
+		items = items[:n]
+	}
+	return items
+}
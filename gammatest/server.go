@@ -0,0 +1,310 @@
+package gammatest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	polymarketgamma "github.com/ivanzzeth/polymarket-go-gamma-client"
+)
+
+// DefaultSearchLimitPerType is the number of results per type returned by /public-search
+// when limit_per_type is not set
+const DefaultSearchLimitPerType = 10
+
+// Dataset is the in-memory data served by Server
+type Dataset struct {
+	Markets          []polymarketgamma.Market
+	Events           []polymarketgamma.Event
+	Series           []polymarketgamma.Series
+	Tags             []polymarketgamma.Tag
+	TagRelationships []polymarketgamma.TagRelationship
+	Teams            []polymarketgamma.Team
+	Sports           []polymarketgamma.SportMetadata
+	Profiles         []polymarketgamma.Profile
+}
+
+// Server is an in-process stand-in for the Gamma API serving a Dataset.
+// List endpoints honor the filters the client sends: limit/offset/order/ascending,
+// id and slug lists, closed, tag_id (with related_tags), date ranges and
+// liquidity/volume bounds. Unknown parameters are ignored.
+type Server struct {
+	URL string // Base URL of the form http://ipaddr:port with no trailing slash
+
+	srv *httptest.Server
+
+	mu   sync.RWMutex
+	data Dataset
+
+	faultMu sync.Mutex
+	rules   []*faultRule
+	calls   []string // Paths of every request received, in order
+}
+
+// NewServer starts a server serving data. Close it when done.
+func NewServer(data Dataset) *Server {
+	s := &Server{data: data}
+	s.srv = httptest.NewServer(s)
+	s.URL = s.srv.URL
+	return s
+}
+
+// Close shuts down the server
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Client returns a client pointed at the server; opts are applied after the base URL
+func (s *Server) Client(opts ...polymarketgamma.Option) *polymarketgamma.Client {
+	return polymarketgamma.NewClient(nil, append([]polymarketgamma.Option{polymarketgamma.WithBaseURL(s.URL)}, opts...)...)
+}
+
+// SetDataset replaces the served data
+func (s *Server) SetDataset(data Dataset) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data = data
+}
+
+// Update modifies the served data in place
+func (s *Server) Update(fn func(data *Dataset)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn(&s.data)
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if fault := s.nextFault(r); fault != nil {
+		s.serveFault(w, r, fault)
+		return
+	}
+	s.serve(w, r)
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	query := r.URL.Query()
+
+	switch segments[0] {
+	case "":
+		writeJSON(w, polymarketgamma.HealthResponse{Data: "OK"})
+	case "markets":
+		s.serveMarkets(w, query, segments[1:])
+	case "events":
+		s.serveEvents(w, query, segments[1:])
+	case "series":
+		s.serveSeries(w, query, segments[1:])
+	case "tags":
+		s.serveTags(w, query, segments[1:])
+	case "teams":
+		if len(segments) != 1 {
+			writeError(w, http.StatusNotFound, "not found")
+			return
+		}
+		s.serveList(w, query, toItems(s.data.Teams), teamFilters)
+	case "sports":
+		if len(segments) != 1 {
+			writeError(w, http.StatusNotFound, "not found")
+			return
+		}
+		writeJSON(w, toItems(s.data.Sports))
+	case "public-search":
+		s.serveSearch(w, query)
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (s *Server) serveMarkets(w http.ResponseWriter, query url.Values, rest []string) {
+	markets := toItems(s.data.Markets)
+
+	// Tags are only embedded on request, but tag_id filtering still sees them
+	withTags := query.Get("include_tag") == "true"
+	stripTags := func(items ...item) {
+		for _, m := range items {
+			if m != nil && !withTags {
+				delete(m, "tags")
+			}
+		}
+	}
+
+	switch {
+	case len(rest) == 0:
+		matched, err := s.list(query, markets, s.marketFilters)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		stripTags(matched...)
+		writeJSON(w, matched)
+	case len(rest) == 2 && rest[0] == "slug":
+		market := find(markets, "slug", rest[1])
+		stripTags(market)
+		writeOne(w, market)
+	case len(rest) == 1:
+		market := find(markets, "id", rest[0])
+		stripTags(market)
+		writeOne(w, market)
+	case len(rest) == 2 && rest[1] == "tags":
+		s.serveItemTags(w, find(markets, "id", rest[0]))
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (s *Server) serveEvents(w http.ResponseWriter, query url.Values, rest []string) {
+	events := toItems(s.data.Events)
+
+	switch {
+	case len(rest) == 0:
+		s.serveList(w, query, events, s.eventFilters)
+	case len(rest) == 2 && rest[0] == "slug":
+		writeOne(w, find(events, "slug", rest[1]))
+	case len(rest) == 1:
+		writeOne(w, find(events, "id", rest[0]))
+	case len(rest) == 2 && rest[1] == "tags":
+		s.serveItemTags(w, find(events, "id", rest[0]))
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (s *Server) serveSeries(w http.ResponseWriter, query url.Values, rest []string) {
+	series := toItems(s.data.Series)
+
+	switch len(rest) {
+	case 0:
+		s.serveList(w, query, series, seriesFilters)
+	case 1:
+		writeOne(w, find(series, "id", rest[0]))
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (s *Server) serveTags(w http.ResponseWriter, query url.Values, rest []string) {
+	tags := toItems(s.data.Tags)
+
+	// Resolve /tags/{id}/... and /tags/slug/{slug}/... to a tag and the remaining segments
+	var tag item
+	switch {
+	case len(rest) == 0:
+		s.serveList(w, query, tags, tagFilters)
+		return
+	case rest[0] == "slug" && len(rest) >= 2:
+		tag, rest = find(tags, "slug", rest[1]), rest[2:]
+	default:
+		tag, rest = find(tags, "id", rest[0]), rest[1:]
+	}
+
+	switch {
+	case len(rest) == 0:
+		writeOne(w, tag)
+	case tag == nil:
+		writeError(w, http.StatusNotFound, "tag not found")
+	case len(rest) == 1 && rest[0] == "related-tags":
+		writeJSON(w, toItems(s.relationships(tag.str("id"))))
+	case len(rest) == 2 && rest[0] == "related-tags" && rest[1] == "tags":
+		related := []item{}
+		for _, rel := range s.relationships(tag.str("id")) {
+			if t := find(tags, "id", strconv.Itoa(rel.RelatedTagID)); t != nil {
+				related = append(related, t)
+			}
+		}
+		writeJSON(w, related)
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+// serveItemTags writes the tags embedded in a market or event
+func (s *Server) serveItemTags(w http.ResponseWriter, it item) {
+	if it == nil {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+	tags, _ := it["tags"].([]any)
+	if tags == nil {
+		tags = []any{}
+	}
+	writeJSON(w, tags)
+}
+
+func (s *Server) relationships(tagID string) []polymarketgamma.TagRelationship {
+	var rels []polymarketgamma.TagRelationship
+	for _, rel := range s.data.TagRelationships {
+		if strconv.Itoa(rel.TagID) == tagID {
+			rels = append(rels, rel)
+		}
+	}
+	return rels
+}
+
+// relatedTagIDs returns tagID plus, when related is set, the IDs of its related tags
+func (s *Server) relatedTagIDs(tagID string, related bool) map[string]bool {
+	ids := map[string]bool{tagID: true}
+	if related {
+		for _, rel := range s.relationships(tagID) {
+			ids[strconv.Itoa(rel.RelatedTagID)] = true
+		}
+	}
+	return ids
+}
+
+// serveList writes the items matching the query's filters and paging
+func (s *Server) serveList(w http.ResponseWriter, query url.Values, items []item, build func(url.Values) (filters, error)) {
+	matched, err := s.list(query, items, build)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	writeJSON(w, matched)
+}
+
+// list filters, sorts and pages items
+func (s *Server) list(query url.Values, items []item, build func(url.Values) (filters, error)) ([]item, error) {
+	fs, err := build(query)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := []item{}
+	for _, it := range items {
+		if fs.match(it) {
+			matched = append(matched, it)
+		}
+	}
+	return page(matched, query)
+}
+
+func writeOne(w http.ResponseWriter, it item) {
+	if it == nil {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+	writeJSON(w, it)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
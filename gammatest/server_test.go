@@ -0,0 +1,256 @@
+package gammatest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	polymarketgamma "github.com/ivanzzeth/polymarket-go-gamma-client"
+)
+
+func date(day int) polymarketgamma.NormalizedTime {
+	return polymarketgamma.NormalizedTime(time.Date(2025, time.January, day, 0, 0, 0, 0, time.UTC))
+}
+
+func testDataset() Dataset {
+	politics := polymarketgamma.Tag{ID: "1", Label: "Politics", Slug: "politics"}
+	elections := polymarketgamma.Tag{ID: "2", Label: "Elections", Slug: "elections"}
+	sports := polymarketgamma.Tag{ID: "3", Label: "Sports", Slug: "sports"}
+
+	var markets []polymarketgamma.Market
+	for i := 1; i <= 10; i++ {
+		m := polymarketgamma.Market{
+			ID:           fmt.Sprint(i),
+			Slug:         fmt.Sprintf("market-%d", i),
+			ConditionID:  fmt.Sprintf("0xc%d", i),
+			ClobTokenIDs: fmt.Sprintf(`["%d1", "%d2"]`, i, i),
+			LiquidityNum: polymarketgamma.FlexFloat(i * 1000),
+			VolumeNum:    polymarketgamma.FlexFloat(i * 500),
+			Volume24hr:   polymarketgamma.FlexFloat(100 - i),
+			EndDate:      date(i),
+			Closed:       i%2 == 0,
+			Tags:         []polymarketgamma.Tag{politics},
+		}
+		if i > 5 {
+			m.Tags = []polymarketgamma.Tag{elections}
+		}
+		markets = append(markets, m)
+	}
+
+	return Dataset{
+		Markets: markets,
+		Events: []polymarketgamma.Event{
+			{ID: "100", Slug: "us-election", Title: "US Election", Active: true, Tags: []polymarketgamma.Tag{politics, elections}, Markets: markets[:2]},
+			{ID: "101", Slug: "nba-finals", Title: "NBA Finals", Active: true, Closed: true, Tags: []polymarketgamma.Tag{sports}},
+			{ID: "102", Slug: "election-turnout", Title: "Election Turnout", Active: true, Tags: []polymarketgamma.Tag{elections}},
+		},
+		Series: []polymarketgamma.Series{
+			{ID: "7", Slug: "weekly", Recurrence: "weekly"},
+			{ID: "8", Slug: "daily", Recurrence: "daily", Closed: true},
+		},
+		Tags: []polymarketgamma.Tag{politics, elections, sports},
+		TagRelationships: []polymarketgamma.TagRelationship{
+			{ID: "r1", TagID: 1, RelatedTagID: 2},
+		},
+		Teams: []polymarketgamma.Team{
+			{ID: 1, Name: "Lakers", League: "nba", Abbreviation: "LAL"},
+			{ID: 2, Name: "Arsenal", League: "epl", Abbreviation: "ARS"},
+		},
+		Sports:   []polymarketgamma.SportMetadata{{Sport: "nba"}},
+		Profiles: []polymarketgamma.Profile{{ID: "p1", Name: "election-whale"}},
+	}
+}
+
+func ids[T any](items []T, id func(T) string) []string {
+	out := make([]string, len(items))
+	for i, it := range items {
+		out[i] = id(it)
+	}
+	return out
+}
+
+func marketIDs(markets []*polymarketgamma.Market) string {
+	return fmt.Sprint(ids(markets, func(m *polymarketgamma.Market) string { return m.ID }))
+}
+
+func TestServerMarkets(t *testing.T) {
+	server := NewServer(testDataset())
+	defer server.Close()
+	client := server.Client()
+	ctx := context.Background()
+
+	closed, asc, includeTag := false, false, true
+	minLiquidity, maxVolume := 3000.0, 4000.0
+	tagID := 1
+	endMin, endMax := date(2), date(9)
+
+	tests := []struct {
+		name   string
+		params *polymarketgamma.GetMarketsParams
+		want   string
+	}{
+		{"All", nil, "[1 2 3 4 5 6 7 8 9 10]"},
+		{"Page", &polymarketgamma.GetMarketsParams{Limit: 3, Offset: 2}, "[3 4 5]"},
+		{"OrderDesc", &polymarketgamma.GetMarketsParams{Order: "liquidityNum", Ascending: &asc, Limit: 2}, "[10 9]"},
+		{"OrderVolume24hr", &polymarketgamma.GetMarketsParams{Order: "volume24hr", Limit: 2}, "[10 9]"},
+		{"Open", &polymarketgamma.GetMarketsParams{Closed: &closed}, "[1 3 5 7 9]"},
+		{"Bounds", &polymarketgamma.GetMarketsParams{LiquidityNumMin: &minLiquidity, VolumeNumMax: &maxVolume}, "[3 4 5 6 7 8]"},
+		{"EndDateRange", &polymarketgamma.GetMarketsParams{EndDateMin: &endMin, EndDateMax: &endMax, Closed: &closed}, "[3 5 7 9]"},
+		{"Tag", &polymarketgamma.GetMarketsParams{TagID: &tagID}, "[1 2 3 4 5]"},
+		{"RelatedTags", &polymarketgamma.GetMarketsParams{TagID: &tagID, RelatedTags: &includeTag, Limit: 7}, "[1 2 3 4 5 6 7]"},
+		{"Slugs", &polymarketgamma.GetMarketsParams{Slug: []string{"market-4", "market-2"}}, "[2 4]"},
+		{"IDs", &polymarketgamma.GetMarketsParams{ID: []int{9, 1}}, "[1 9]"},
+		{"ConditionIDs", &polymarketgamma.GetMarketsParams{ConditionIDs: []string{"0XC3"}}, "[3]"},
+		{"TokenIDs", &polymarketgamma.GetMarketsParams{ClobTokenIDs: []string{"52", "71"}}, "[5 7]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			markets, err := client.GetMarkets(ctx, tt.params)
+			if err != nil {
+				t.Fatalf("GetMarkets failed: %v", err)
+			}
+			if got := marketIDs(markets); got != tt.want {
+				t.Errorf("markets = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("IncludeTag", func(t *testing.T) {
+		markets, _ := client.GetMarkets(ctx, &polymarketgamma.GetMarketsParams{Limit: 1})
+		if len(markets[0].Tags) != 0 {
+			t.Error("tags must be omitted unless include_tag=true")
+		}
+		markets, _ = client.GetMarkets(ctx, &polymarketgamma.GetMarketsParams{Limit: 1, IncludeTag: &includeTag})
+		if len(markets[0].Tags) != 1 {
+			t.Errorf("tags = %v, want the politics tag", markets[0].Tags)
+		}
+	})
+
+	t.Run("ByIDAndSlug", func(t *testing.T) {
+		market, err := client.GetMarketBySlug(ctx, "market-3", nil)
+		if err != nil || market.ID != "3" {
+			t.Errorf("GetMarketBySlug = %v, %v", market, err)
+		}
+		market, err = client.GetMarketByID(ctx, "4", nil)
+		if err != nil || market.Slug != "market-4" {
+			t.Errorf("GetMarketByID = %v, %v", market, err)
+		}
+		if _, err := client.GetMarketByID(ctx, "404", nil); !polymarketgamma.IsNotFound(err) {
+			t.Errorf("expected not found, got %v", err)
+		}
+		tags, err := client.GetMarketTags(ctx, "7")
+		if err != nil || len(tags) != 1 || tags[0].Slug != "elections" {
+			t.Errorf("GetMarketTags = %v, %v", tags, err)
+		}
+	})
+
+	t.Run("Pagination", func(t *testing.T) {
+		var got []*polymarketgamma.Market
+		for market, err := range client.AllMarkets(ctx, &polymarketgamma.GetMarketsParams{Closed: &closed}, polymarketgamma.WithPageSize(2)) {
+			if err != nil {
+				t.Fatalf("AllMarkets failed: %v", err)
+			}
+			got = append(got, market)
+		}
+		if marketIDs(got) != "[1 3 5 7 9]" {
+			t.Errorf("AllMarkets = %s", marketIDs(got))
+		}
+	})
+
+	t.Run("BadRequest", func(t *testing.T) {
+		resp, err := http.Get(server.URL + "/markets?limit=abc")
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("status = %d, want 400", resp.StatusCode)
+		}
+	})
+}
+
+func TestServerEventsSeriesTags(t *testing.T) {
+	server := NewServer(testDataset())
+	defer server.Close()
+	client := server.Client()
+	ctx := context.Background()
+
+	closed := false
+	tagID := 3
+	events, err := client.GetEvents(ctx, &polymarketgamma.GetEventsParams{ExcludeTagID: []int{tagID}})
+	if err != nil {
+		t.Fatalf("GetEvents failed: %v", err)
+	}
+	if got := fmt.Sprint(ids(events, func(e polymarketgamma.Event) string { return e.ID })); got != "[100 102]" {
+		t.Errorf("events excluding sports = %s", got)
+	}
+
+	events, _ = client.GetEvents(ctx, &polymarketgamma.GetEventsParams{Closed: &closed, Slug: []string{"nba-finals", "us-election"}})
+	if len(events) != 1 || events[0].ID != "100" {
+		t.Errorf("open events by slug = %v", events)
+	}
+
+	event, err := client.GetEventBySlug(ctx, "us-election", nil)
+	if err != nil || len(event.Markets) != 2 {
+		t.Errorf("GetEventBySlug = %v, %v", event, err)
+	}
+
+	series, err := client.GetSeries(ctx, &polymarketgamma.GetSeriesParams{Closed: &closed})
+	if err != nil || len(series) != 1 || series[0].Slug != "weekly" {
+		t.Errorf("GetSeries = %v, %v", series, err)
+	}
+	if s, err := client.GetSeriesByID(ctx, "8", nil); err != nil || s.Recurrence != "daily" {
+		t.Errorf("GetSeriesByID = %v, %v", s, err)
+	}
+
+	related, err := client.GetRelatedTagsDetailBySlug(ctx, "politics", nil)
+	if err != nil || len(related) != 1 || related[0].Slug != "elections" {
+		t.Errorf("GetRelatedTagsDetailBySlug = %v, %v", related, err)
+	}
+	rels, err := client.GetRelatedTagsByID(ctx, "1", nil)
+	if err != nil || len(rels) != 1 || rels[0].RelatedTagID != 2 {
+		t.Errorf("GetRelatedTagsByID = %v, %v", rels, err)
+	}
+
+	teams, err := client.GetTeams(ctx, &polymarketgamma.GetTeamsParams{League: []string{"NBA"}})
+	if err != nil || len(teams) != 1 || teams[0].Name != "Lakers" {
+		t.Errorf("GetTeams = %v, %v", teams, err)
+	}
+	sports, err := client.GetSportsMetadata(ctx)
+	if err != nil || len(sports) != 1 {
+		t.Errorf("GetSportsMetadata = %v, %v", sports, err)
+	}
+	health, err := client.HealthCheck(ctx)
+	if err != nil || health.Data != "OK" {
+		t.Errorf("HealthCheck = %v, %v", health, err)
+	}
+}
+
+func TestServerSearch(t *testing.T) {
+	server := NewServer(testDataset())
+	defer server.Close()
+	client := server.Client()
+	ctx := context.Background()
+
+	limit, keepClosed := 1, 0
+	result, err := client.Search(ctx, &polymarketgamma.SearchParams{Q: "election", LimitPerType: &limit, KeepClosedMarkets: &keepClosed})
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if len(result.Events) != 1 || result.Events[0].ID != "100" || !result.Pagination.HasMore || result.Pagination.TotalResults != 2 {
+		t.Errorf("unexpected first page: %+v", result)
+	}
+	if len(result.Events[0].Markets) != 1 {
+		t.Errorf("keep_closed_markets=0 must drop closed markets, got %d", len(result.Events[0].Markets))
+	}
+	if len(result.Tags) != 1 || result.Tags[0].EventCount != 2 || len(result.Profiles) != 1 {
+		t.Errorf("unexpected tags/profiles: %+v %+v", result.Tags, result.Profiles)
+	}
+
+	all, err := client.SearchAll(ctx, &polymarketgamma.SearchParams{Q: "election", LimitPerType: &limit}, 0)
+	if err != nil || len(all.Events) != 2 || all.HasMore {
+		t.Errorf("SearchAll = %+v, %v", all, err)
+	}
+}
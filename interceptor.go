@@ -0,0 +1,139 @@
+package polymarketgamma
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// Call describes one Client method call as it passes through the interceptor chain
+type Call struct {
+	Endpoint string      // Client method name, e.g. "GetMarkets"
+	Params   any         // Params struct pointer passed to the method, or nil for methods without params
+	Method   string      // HTTP method
+	Path     string      // Request path including the query string
+	Header   http.Header // Extra request headers; interceptors may add to it
+}
+
+// Response is the outcome of a call as seen by interceptors.
+// It is non-nil even when the call fails, so the status of a failed request is visible.
+type Response struct {
+	StatusCode int    // HTTP status of the last attempt (0 if no response was received)
+	Body       []byte // Raw response body; interceptors may replace it. Nil for Stream methods.
+	Cached     bool   // Served from the in-memory cache without a request
+	Attempts   int    // Requests sent for this call, including retries (0 for cache hits)
+}
+
+// Handler performs a call
+type Handler func(ctx context.Context, call *Call) (*Response, error)
+
+// Interceptor wraps a Handler to add behavior around every call: logging, metrics, extra headers,
+// or replacing responses in tests. It must call next unless it answers the call itself.
+type Interceptor func(next Handler) Handler
+
+// WithInterceptors adds interceptors around every API call. The first interceptor is the outermost.
+// Interceptors run outside the cache and retries: a cache hit reaches them with Cached set,
+// and a retried request is seen once. For Stream methods the chain completes once the response
+// headers arrive, so Body is nil and the latency excludes reading the body.
+func WithInterceptors(interceptors ...Interceptor) Option {
+	return func(c *Client) {
+		c.interceptors = append(c.interceptors, interceptors...)
+	}
+}
+
+// buildHandler chains the configured interceptors around the terminal handler
+func (c *Client) buildHandler(terminal Handler) Handler {
+	handler := terminal
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		handler = c.interceptors[i](handler)
+	}
+	return handler
+}
+
+// CallStats summarizes a completed call for the built-in interceptors
+type CallStats struct {
+	Endpoint   string        // Client method name
+	Path       string        // Request path including the query string
+	Params     any           // Params struct pointer passed to the method, or nil
+	StatusCode int           // HTTP status of the last attempt (0 if none)
+	Bytes      int           // Response body size
+	Latency    time.Duration // Time spent in the rest of the chain
+	Cached     bool          // Served from the in-memory cache
+	Attempts   int           // Requests sent, including retries
+	Err        error         // Call error, if any
+}
+
+// TimingInterceptor reports the outcome and latency of every call to fn
+func TimingInterceptor(fn func(CallStats)) Interceptor {
+	return func(next Handler) Handler {
+		return observe(next, func(_ context.Context, s CallStats) { fn(s) })
+	}
+}
+
+// LoggingInterceptor logs every call to logger (slog.Default() if nil):
+// successful calls at Info level, failed calls at Error level
+func LoggingInterceptor(logger *slog.Logger) Interceptor {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return func(next Handler) Handler {
+		return observe(next, func(ctx context.Context, s CallStats) {
+			attrs := []slog.Attr{
+				slog.String("endpoint", s.Endpoint),
+				slog.String("path", s.Path),
+				slog.Int("status", s.StatusCode),
+				slog.Int("bytes", s.Bytes),
+				slog.Duration("latency", s.Latency),
+				slog.Bool("cached", s.Cached),
+				slog.Int("attempts", s.Attempts),
+			}
+
+			if s.Err != nil {
+				attrs = append(attrs, slog.String("error", s.Err.Error()))
+				logger.LogAttrs(ctx, slog.LevelError, "gamma request failed", attrs...)
+				return
+			}
+			logger.LogAttrs(ctx, slog.LevelInfo, "gamma request", attrs...)
+		})
+	}
+}
+
+// observe wraps next and reports each completed call to fn
+func observe(next Handler, fn func(context.Context, CallStats)) Handler {
+	return func(ctx context.Context, call *Call) (*Response, error) {
+		start := time.Now()
+		resp, err := next(ctx, call)
+
+		stats := CallStats{
+			Endpoint: call.Endpoint,
+			Path:     call.Path,
+			Params:   call.Params,
+			Latency:  time.Since(start),
+			Err:      err,
+		}
+		if resp != nil {
+			stats.StatusCode = resp.StatusCode
+			stats.Bytes = len(resp.Body)
+			stats.Cached = resp.Cached
+			stats.Attempts = resp.Attempts
+		}
+		fn(ctx, stats)
+
+		return resp, err
+	}
+}
+
+// statusOf returns the HTTP status implied by a call result
+func statusOf(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
@@ -0,0 +1,150 @@
+package polymarketgamma
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestInterceptorChain(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Proxy-Auth") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path == "/markets/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`[{"id":"1"},{"id":"2"}]`))
+	}))
+	defer server.Close()
+
+	var order []string
+	named := func(name string) Interceptor {
+		return func(next Handler) Handler {
+			return func(ctx context.Context, call *Call) (*Response, error) {
+				order = append(order, name)
+				return next(ctx, call)
+			}
+		}
+	}
+	auth := func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) (*Response, error) {
+			call.Header.Set("X-Proxy-Auth", "secret")
+			return next(ctx, call)
+		}
+	}
+
+	var mu sync.Mutex
+	var stats []CallStats
+	client := NewClient(nil, WithBaseURL(server.URL),
+		WithCache(CacheConfig{DefaultTTL: time.Minute}),
+		WithInterceptors(named("outer"), named("inner")),
+		WithInterceptors(auth, TimingInterceptor(func(s CallStats) {
+			mu.Lock()
+			defer mu.Unlock()
+			stats = append(stats, s)
+		})),
+	)
+	ctx := context.Background()
+
+	params := &GetMarketsParams{Limit: 2}
+	if markets, err := client.GetMarkets(ctx, params); err != nil || len(markets) != 2 {
+		t.Fatalf("GetMarkets = %d markets, %v", len(markets), err)
+	}
+	if _, err := client.GetMarkets(ctx, params); err != nil {
+		t.Fatalf("cached GetMarkets failed: %v", err)
+	}
+	if _, err := client.GetMarketByID(ctx, "missing", nil); err == nil {
+		t.Fatal("GetMarketByID succeeded, want 404")
+	}
+
+	if strings.Join(order, ",") != "outer,inner,outer,inner,outer,inner" {
+		t.Errorf("order = %v", order)
+	}
+	if len(stats) != 3 {
+		t.Fatalf("stats = %+v", stats)
+	}
+
+	first := stats[0]
+	if first.Endpoint != "GetMarkets" || first.Params != params || first.Path != "/markets?limit=2" ||
+		first.StatusCode != http.StatusOK || first.Bytes != 23 || first.Cached || first.Latency <= 0 {
+		t.Errorf("first call = %+v", first)
+	}
+	if !stats[1].Cached || stats[1].Bytes != 23 {
+		t.Errorf("second call = %+v, want a cache hit", stats[1])
+	}
+	if stats[2].Endpoint != "GetMarketByID" || stats[2].StatusCode != http.StatusNotFound || stats[2].Err == nil {
+		t.Errorf("failed call = %+v", stats[2])
+	}
+}
+
+func TestInterceptorReplacesResponse(t *testing.T) {
+	fake := func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) (*Response, error) {
+			if call.Params != nil {
+				t.Errorf("%s Params = %#v, want untyped nil for nil params", call.Endpoint, call.Params)
+			}
+			if call.Endpoint == "GetMarkets" || call.Endpoint == "StreamMarkets" {
+				return &Response{StatusCode: http.StatusOK, Body: []byte(`[{"id":"fake"}]`)}, nil
+			}
+			return next(ctx, call)
+		}
+	}
+
+	// The base URL is unreachable: only the interceptor can answer
+	client := NewClient(nil, WithBaseURL("http://127.0.0.1:1"), WithInterceptors(fake))
+	ctx := context.Background()
+
+	markets, err := client.GetMarkets(ctx, nil)
+	if err != nil || len(markets) != 1 || markets[0].ID != "fake" {
+		t.Errorf("GetMarkets = %+v, %v", markets, err)
+	}
+
+	for market, err := range client.StreamMarkets(ctx, nil) {
+		if err != nil || market.ID != "fake" {
+			t.Errorf("StreamMarkets = %+v, %v", market, err)
+		}
+	}
+}
+
+func TestLoggingInterceptor(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/events" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"data":"OK"}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+	client := NewClient(nil, WithBaseURL(server.URL), WithInterceptors(LoggingInterceptor(logger)))
+	ctx := context.Background()
+
+	client.HealthCheck(ctx)
+	client.GetEvents(ctx, nil)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("log = %q", buf.String())
+	}
+	for _, want := range []string{"level=INFO", `msg="gamma request"`, "endpoint=HealthCheck", "status=200", "bytes=13"} {
+		if !strings.Contains(lines[0], want) {
+			t.Errorf("log line %q does not contain %q", lines[0], want)
+		}
+	}
+	for _, want := range []string{"level=ERROR", "endpoint=GetEvents", "status=503", "error="} {
+		if !strings.Contains(lines[1], want) {
+			t.Errorf("log line %q does not contain %q", lines[1], want)
+		}
+	}
+}
@@ -9,7 +9,7 @@ import (
 )
 
 func TestAllMarketsFunctions(t *testing.T) {
-	client := NewClient(http.DefaultClient)
+	client := newRecordedClient(t)
 	ctx := context.Background()
 
 	// Step 1: Get markets
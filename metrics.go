@@ -0,0 +1,247 @@
+package polymarketgamma
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultLatencyBuckets are the latency histogram upper bounds in seconds used when NewMetrics gets none
+var DefaultLatencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// Metrics collects client request metrics per endpoint and serves them in the Prometheus text
+// exposition format. It implements http.Handler, so it can be mounted on a local mux and scraped.
+// One Metrics may be shared by several clients.
+type Metrics struct {
+	buckets []float64
+
+	mu        sync.Mutex
+	endpoints map[string]*endpointMetrics
+}
+
+type endpointMetrics struct {
+	requests  uint64
+	errors    map[string]uint64 // by class: "4xx", "5xx", "other" or "decode"
+	retries   uint64
+	cacheHits uint64
+	bytes     uint64
+
+	buckets []uint64 // cumulative counts are computed when rendering
+	sum     float64
+	count   uint64
+}
+
+// NewMetrics creates an empty collector. buckets are the latency histogram upper bounds in seconds;
+// DefaultLatencyBuckets are used if none are given.
+func NewMetrics(buckets ...float64) *Metrics {
+	if len(buckets) == 0 {
+		buckets = DefaultLatencyBuckets
+	}
+	buckets = slices.Clone(buckets)
+	slices.Sort(buckets)
+
+	return &Metrics{
+		buckets:   buckets,
+		endpoints: make(map[string]*endpointMetrics),
+	}
+}
+
+// WithMetrics records every call made by the client into m, including responses that fail to decode
+func WithMetrics(m *Metrics) Option {
+	return func(c *Client) {
+		c.metrics = append(c.metrics, m)
+		WithInterceptors(m.Interceptor())(c)
+	}
+}
+
+// Interceptor returns an interceptor that records calls into m. Decoding happens after the interceptor
+// chain, so only WithMetrics counts decode errors.
+func (m *Metrics) Interceptor() Interceptor {
+	return func(next Handler) Handler {
+		return observe(next, func(_ context.Context, s CallStats) {
+			m.record(metricsEndpoint(s.Path), s)
+		})
+	}
+}
+
+// metricsEndpoint maps a request path to its endpoint label
+func metricsEndpoint(path string) string {
+	switch family := endpointFamily(path); family {
+	case "/":
+		return "health"
+	case "/public-search":
+		return "search"
+	case "/teams", "/sports":
+		return "sports"
+	default:
+		return strings.TrimPrefix(family, "/")
+	}
+}
+
+// statusClass returns the error label for a failed call
+func statusClass(status int) string {
+	switch {
+	case status >= 400 && status < 500:
+		return "4xx"
+	case status >= 500 && status < 600:
+		return "5xx"
+	default:
+		return "other"
+	}
+}
+
+// endpoint returns the metrics of an endpoint, creating them if needed. m.mu must be held.
+func (m *Metrics) endpoint(endpoint string) *endpointMetrics {
+	em, ok := m.endpoints[endpoint]
+	if !ok {
+		em = &endpointMetrics{errors: make(map[string]uint64), buckets: make([]uint64, len(m.buckets))}
+		m.endpoints[endpoint] = em
+	}
+	return em
+}
+
+func (m *Metrics) record(endpoint string, s CallStats) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	em := m.endpoint(endpoint)
+	em.requests++
+	if s.Err != nil {
+		em.errors[statusClass(s.StatusCode)]++
+	}
+	if s.Attempts > 1 {
+		em.retries += uint64(s.Attempts - 1)
+	}
+	if s.Cached {
+		em.cacheHits++
+	}
+	em.bytes += uint64(s.Bytes)
+
+	seconds := s.Latency.Seconds()
+	if i, _ := slices.BinarySearch(m.buckets, seconds); i < len(em.buckets) {
+		em.buckets[i]++
+	}
+	em.sum += seconds
+	em.count++
+}
+
+// recordDecodeError counts a call whose response failed to decode, fully or partially
+func (m *Metrics) recordDecodeError(endpoint string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.endpoint(endpoint).errors["decode"]++
+}
+
+// ServeHTTP renders the metrics in the Prometheus text exposition format
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	m.WriteTo(w)
+}
+
+// WriteTo writes the metrics in the Prometheus text exposition format
+func (m *Metrics) WriteTo(w io.Writer) (int64, error) {
+	// Render from a copy, so a slow writer never blocks calls recording into m
+	snapshot := m.snapshot()
+
+	cw := &countingWriter{w: bufio.NewWriter(w)}
+
+	endpoints := make([]string, 0, len(snapshot))
+	for endpoint := range snapshot {
+		endpoints = append(endpoints, endpoint)
+	}
+	slices.Sort(endpoints)
+
+	counter := func(name, help string, value func(*endpointMetrics) uint64) {
+		cw.printf("# HELP %s %s\n# TYPE %s counter\n", name, help, name)
+		for _, endpoint := range endpoints {
+			cw.printf("%s{endpoint=%q} %d\n", name, endpoint, value(snapshot[endpoint]))
+		}
+	}
+
+	counter("gamma_client_requests_total", "Client calls, including cache hits.",
+		func(em *endpointMetrics) uint64 { return em.requests })
+
+	cw.printf("# HELP gamma_client_errors_total Failed client calls by HTTP status class, or decode for undecodable responses.\n")
+	cw.printf("# TYPE gamma_client_errors_total counter\n")
+	for _, endpoint := range endpoints {
+		em := snapshot[endpoint]
+		for _, class := range []string{"4xx", "5xx", "other", "decode"} {
+			if n, ok := em.errors[class]; ok {
+				cw.printf("gamma_client_errors_total{endpoint=%q,class=%q} %d\n", endpoint, class, n)
+			}
+		}
+	}
+
+	counter("gamma_client_retries_total", "Requests resent after a retryable failure.",
+		func(em *endpointMetrics) uint64 { return em.retries })
+	counter("gamma_client_cache_hits_total", "Calls served from the in-memory cache.",
+		func(em *endpointMetrics) uint64 { return em.cacheHits })
+	counter("gamma_client_response_bytes_total", "Response body bytes returned to callers.",
+		func(em *endpointMetrics) uint64 { return em.bytes })
+
+	const histogram = "gamma_client_request_duration_seconds"
+	cw.printf("# HELP %s Client call latency.\n# TYPE %s histogram\n", histogram, histogram)
+	for _, endpoint := range endpoints {
+		em := snapshot[endpoint]
+		var cumulative uint64
+		for i, bound := range m.buckets {
+			cumulative += em.buckets[i]
+			cw.printf("%s_bucket{endpoint=%q,le=%q} %d\n", histogram, endpoint, formatFloat(bound), cumulative)
+		}
+		cw.printf("%s_bucket{endpoint=%q,le=\"+Inf\"} %d\n", histogram, endpoint, em.count)
+		cw.printf("%s_sum{endpoint=%q} %s\n", histogram, endpoint, formatFloat(em.sum))
+		cw.printf("%s_count{endpoint=%q} %d\n", histogram, endpoint, em.count)
+	}
+
+	if cw.err == nil {
+		cw.err = cw.w.Flush()
+	}
+	return cw.n, cw.err
+}
+
+// snapshot returns a deep copy of the per-endpoint metrics
+func (m *Metrics) snapshot() map[string]*endpointMetrics {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	snapshot := make(map[string]*endpointMetrics, len(m.endpoints))
+	for endpoint, em := range m.endpoints {
+		c := *em
+		c.errors = maps.Clone(em.errors)
+		c.buckets = slices.Clone(em.buckets)
+		snapshot[endpoint] = &c
+	}
+	return snapshot
+}
+
+func formatFloat(f float64) string {
+	if math.IsInf(f, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// countingWriter tracks bytes written and the first write error
+type countingWriter struct {
+	w   *bufio.Writer
+	n   int64
+	err error
+}
+
+func (cw *countingWriter) printf(format string, args ...any) {
+	if cw.err != nil {
+		return
+	}
+	n, err := fmt.Fprintf(cw.w, format, args...)
+	cw.n += int64(n)
+	cw.err = err
+}
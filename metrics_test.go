@@ -0,0 +1,175 @@
+package polymarketgamma
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/events":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case "/tags/missing":
+			w.WriteHeader(http.StatusNotFound)
+		case "/":
+			w.Write([]byte(`{"data":"OK"}`))
+		default:
+			w.Write([]byte(`[{"id":"1"}]`))
+		}
+	}))
+	defer server.Close()
+
+	metrics := NewMetrics(0.5, 1)
+	client := NewClient(nil, WithBaseURL(server.URL), WithMetrics(metrics),
+		WithCache(CacheConfig{TTLs: map[string]time.Duration{"markets": time.Minute}}),
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 3, RetryableStatusCodes: []int{http.StatusServiceUnavailable}}),
+	)
+	ctx := context.Background()
+
+	client.GetMarkets(ctx, nil)
+	client.GetMarkets(ctx, nil) // cache hit
+	client.GetEvents(ctx, nil)  // 503 after 3 attempts
+	client.GetTagByID(ctx, "missing", nil)
+	client.GetTeams(ctx, nil)
+	client.HealthCheck(ctx)
+
+	rec := httptest.NewRecorder()
+	metrics.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain; version=0.0.4") {
+		t.Errorf("Content-Type = %q", ct)
+	}
+
+	body := rec.Body.String()
+	for _, want := range []string{
+		"# TYPE gamma_client_requests_total counter",
+		`gamma_client_requests_total{endpoint="markets"} 2`,
+		`gamma_client_requests_total{endpoint="health"} 1`,
+		`gamma_client_requests_total{endpoint="sports"} 1`,
+		`gamma_client_errors_total{endpoint="events",class="5xx"} 1`,
+		`gamma_client_errors_total{endpoint="tags",class="4xx"} 1`,
+		`gamma_client_retries_total{endpoint="events"} 2`,
+		`gamma_client_retries_total{endpoint="markets"} 0`,
+		`gamma_client_cache_hits_total{endpoint="markets"} 1`,
+		`gamma_client_response_bytes_total{endpoint="markets"} 24`,
+		`gamma_client_response_bytes_total{endpoint="health"} 13`,
+		"# TYPE gamma_client_request_duration_seconds histogram",
+		`gamma_client_request_duration_seconds_bucket{endpoint="markets",le="0.5"} 2`,
+		`gamma_client_request_duration_seconds_bucket{endpoint="markets",le="1"} 2`,
+		`gamma_client_request_duration_seconds_bucket{endpoint="markets",le="+Inf"} 2`,
+		`gamma_client_request_duration_seconds_count{endpoint="markets"} 2`,
+	} {
+		if !strings.Contains(body, want+"\n") {
+			t.Errorf("metrics missing %q\n%s", want, body)
+		}
+	}
+	if strings.Contains(body, `endpoint="markets",class=`) {
+		t.Errorf("markets reported errors:\n%s", body)
+	}
+}
+
+func TestMetricsEndpoint(t *testing.T) {
+	tests := map[string]string{
+		"/markets?limit=1":          "markets",
+		"/markets/slug/foo":         "markets",
+		"/events/1/tags":            "events",
+		"/series":                   "series",
+		"/tags/slug/x/related-tags": "tags",
+		"/public-search?q=x":        "search",
+		"/teams":                    "sports",
+		"/sports":                   "sports",
+		"/":                         "health",
+	}
+	for path, want := range tests {
+		if got := metricsEndpoint(path); got != want {
+			t.Errorf("metricsEndpoint(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
+
+func TestMetricsDecodeErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/markets":
+			w.Write([]byte(`[{"id":"1"},{"id":"2","volumeNum":{}}]`))
+		case "/events":
+			w.Write([]byte(`[{"id":"1","volume":{}}]`))
+		default:
+			w.Write([]byte(`{"id":`))
+		}
+	}))
+	defer server.Close()
+
+	metrics := NewMetrics()
+	client := NewClient(nil, WithBaseURL(server.URL), WithMetrics(metrics), WithPartialDecoding())
+	ctx := context.Background()
+
+	if _, err := client.GetMarkets(ctx, nil); err == nil {
+		t.Fatal("GetMarkets succeeded, want a partial decode error")
+	}
+	if _, err := client.GetTagByID(ctx, "1", nil); err == nil {
+		t.Fatal("GetTagByID succeeded, want a decode error")
+	}
+	for range client.StreamEvents(ctx, nil) {
+	}
+
+	var body strings.Builder
+	metrics.WriteTo(&body)
+	for _, want := range []string{
+		`gamma_client_requests_total{endpoint="markets"} 1`,
+		`gamma_client_errors_total{endpoint="markets",class="decode"} 1`,
+		`gamma_client_errors_total{endpoint="tags",class="decode"} 1`,
+		`gamma_client_errors_total{endpoint="events",class="decode"} 1`,
+	} {
+		if !strings.Contains(body.String(), want+"\n") {
+			t.Errorf("metrics missing %q\n%s", want, body.String())
+		}
+	}
+}
+
+// blockingWriter blocks every write until release is closed
+type blockingWriter struct {
+	writing chan struct{}
+	release chan struct{}
+}
+
+func (w *blockingWriter) Write(p []byte) (int, error) {
+	select {
+	case w.writing <- struct{}{}:
+	default:
+	}
+	<-w.release
+	return len(p), nil
+}
+
+func TestMetricsSlowScrapeDoesNotBlockRecording(t *testing.T) {
+	metrics := NewMetrics()
+	metrics.record("markets", CallStats{Latency: time.Millisecond})
+
+	w := &blockingWriter{writing: make(chan struct{}, 1), release: make(chan struct{})}
+	done := make(chan struct{})
+	go func() {
+		metrics.WriteTo(w)
+		close(done)
+	}()
+	<-w.writing
+
+	recorded := make(chan struct{})
+	go func() {
+		metrics.record("markets", CallStats{Latency: time.Millisecond})
+		close(recorded)
+	}()
+	select {
+	case <-recorded:
+	case <-time.After(time.Second):
+		t.Fatal("record blocked while a scrape was writing")
+	}
+
+	close(w.release)
+	<-done
+}
@@ -0,0 +1,66 @@
+package polymarketgamma
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithBaseURL overrides the Gamma API base URL (e.g. a staging host, a local mirror or an httptest.Server)
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.host = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithHTTPClient replaces the underlying http.Client
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		if httpClient != nil {
+			c.httpClient = httpClient
+		}
+	}
+}
+
+// WithTransport sets the http.RoundTripper used for outgoing requests.
+// The caller's http.Client is copied, never modified.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *Client) {
+		c.transport = transport
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithHeader adds a default header sent with every request
+func WithHeader(key, value string) Option {
+	return func(c *Client) {
+		c.headers.Add(key, value)
+	}
+}
+
+// WithHeaders adds default headers sent with every request
+func WithHeaders(headers http.Header) Option {
+	return func(c *Client) {
+		for key, values := range headers {
+			for _, value := range values {
+				c.headers.Add(key, value)
+			}
+		}
+	}
+}
+
+// WithTimeout sets a per-request timeout applied on top of the caller's context
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
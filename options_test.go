@@ -0,0 +1,99 @@
+package polymarketgamma
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestClientOptions(t *testing.T) {
+	var gotPath, gotUserAgent, gotCustom string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotUserAgent = r.Header.Get("User-Agent")
+		gotCustom = r.Header.Get("X-Custom")
+		w.Write([]byte(`{"data":"OK"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(nil,
+		WithBaseURL(server.URL+"/"),
+		WithUserAgent("scanner/1.0"),
+		WithHeader("X-Custom", "value"),
+	)
+
+	if client.BaseURL() != server.URL {
+		t.Errorf("BaseURL() = %q, want %q", client.BaseURL(), server.URL)
+	}
+
+	health, err := client.HealthCheck(context.Background())
+	if err != nil {
+		t.Fatalf("HealthCheck failed: %v", err)
+	}
+	if health.Data != "OK" {
+		t.Errorf("health.Data = %q, want OK", health.Data)
+	}
+	if gotPath != "/" {
+		t.Errorf("path = %q, want /", gotPath)
+	}
+	if gotUserAgent != "scanner/1.0" {
+		t.Errorf("User-Agent = %q, want scanner/1.0", gotUserAgent)
+	}
+	if gotCustom != "value" {
+		t.Errorf("X-Custom = %q, want value", gotCustom)
+	}
+}
+
+func TestClientDefaults(t *testing.T) {
+	client := NewClient(http.DefaultClient)
+	if client.BaseURL() != GammaAPIURL {
+		t.Errorf("BaseURL() = %q, want %q", client.BaseURL(), GammaAPIURL)
+	}
+	if client.httpClient != http.DefaultClient {
+		t.Error("expected the caller's http.Client to be used as-is")
+	}
+}
+
+func TestWithTransport(t *testing.T) {
+	called := false
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		called = true
+		rec := httptest.NewRecorder()
+		rec.WriteString(`{"data":"OK"}`)
+		return rec.Result(), nil
+	})
+
+	client := NewClient(http.DefaultClient, WithTransport(transport))
+	if _, err := client.HealthCheck(context.Background()); err != nil {
+		t.Fatalf("HealthCheck failed: %v", err)
+	}
+	if !called {
+		t.Error("custom transport was not used")
+	}
+	if http.DefaultClient.Transport != nil {
+		t.Error("http.DefaultClient must not be modified")
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL), WithTimeout(20*time.Millisecond))
+	if _, err := client.HealthCheck(context.Background()); err == nil {
+		t.Fatal("expected timeout error")
+	}
+}
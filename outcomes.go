@@ -0,0 +1,123 @@
+package polymarketgamma
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrOutcomeMismatch is returned by Market.OutcomeList when outcomes, prices and token IDs have different lengths
+var ErrOutcomeMismatch = errors.New("outcome count mismatch")
+
+// Outcome is one outcome of a market with its current price and CLOB token
+type Outcome struct {
+	Name    string
+	Price   float64 // Zero when the market has no outcome prices
+	TokenID string  // Empty when the market has no CLOB tokens
+}
+
+// TokenIDs decodes the JSON-encoded ClobTokenIDs field.
+// Markets without CLOB tokens return an empty slice.
+func (m *Market) TokenIDs() ([]string, error) {
+	ids, err := parseStringList(m.ClobTokenIDs)
+	if err != nil {
+		return nil, fmt.Errorf("invalid clobTokenIds: %w", err)
+	}
+	return ids, nil
+}
+
+// OutcomePricesFloat parses OutcomePrices, which the API sends as decimal strings
+func (m *Market) OutcomePricesFloat() ([]float64, error) {
+	prices := make([]float64, len(m.OutcomePrices))
+	for i, raw := range m.OutcomePrices {
+		price, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid outcome price %q at index %d: %w", raw, i, err)
+		}
+		prices[i] = price
+	}
+	return prices, nil
+}
+
+// OutcomeList zips Outcomes, OutcomePrices and ClobTokenIDs by index.
+// Missing prices or token IDs are allowed, but when present their count must match
+// the outcome count, otherwise the error wraps ErrOutcomeMismatch.
+func (m *Market) OutcomeList() ([]Outcome, error) {
+	prices, err := m.OutcomePricesFloat()
+	if err != nil {
+		return nil, err
+	}
+	tokenIDs, err := m.TokenIDs()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(prices) > 0 && len(prices) != len(m.Outcomes) {
+		return nil, fmt.Errorf("%w: %d outcomes, %d prices", ErrOutcomeMismatch, len(m.Outcomes), len(prices))
+	}
+	if len(tokenIDs) > 0 && len(tokenIDs) != len(m.Outcomes) {
+		return nil, fmt.Errorf("%w: %d outcomes, %d token IDs", ErrOutcomeMismatch, len(m.Outcomes), len(tokenIDs))
+	}
+
+	outcomes := make([]Outcome, len(m.Outcomes))
+	for i, name := range m.Outcomes {
+		outcomes[i].Name = name
+		if len(prices) > 0 {
+			outcomes[i].Price = prices[i]
+		}
+		if len(tokenIDs) > 0 {
+			outcomes[i].TokenID = tokenIDs[i]
+		}
+	}
+	return outcomes, nil
+}
+
+// UMAResolutionStatusList decodes the JSON-encoded UMAResolutionStatuses field,
+// the history of UMA oracle statuses such as "proposed" or "disputed"
+func (m *Market) UMAResolutionStatusList() ([]string, error) {
+	statuses, err := parseStringList(m.UMAResolutionStatuses)
+	if err != nil {
+		return nil, fmt.Errorf("invalid umaResolutionStatuses: %w", err)
+	}
+	return statuses, nil
+}
+
+// PastSlugList decodes the PastSlugs field, sent either as a JSON array or a comma-separated list
+func (m *Market) PastSlugList() ([]string, error) {
+	raw := strings.TrimSpace(m.PastSlugs)
+	if raw != "" && raw[0] != '[' {
+		var slugs []string
+		for _, slug := range strings.Split(raw, ",") {
+			if slug = strings.TrimSpace(slug); slug != "" {
+				slugs = append(slugs, slug)
+			}
+		}
+		return slugs, nil
+	}
+
+	slugs, err := parseStringList(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pastSlugs: %w", err)
+	}
+	return slugs, nil
+}
+
+// parseStringList decodes a JSON array of strings embedded in a string field.
+// Empty and "null" values decode to an empty slice.
+func parseStringList(raw string) ([]string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" || raw == "null" {
+		return []string{}, nil
+	}
+
+	var values []string
+	if err := json.Unmarshal([]byte(raw), &values); err != nil {
+		return nil, err
+	}
+	if values == nil {
+		values = []string{}
+	}
+	return values, nil
+}
@@ -0,0 +1,106 @@
+package polymarketgamma
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestMarketOutcomeList(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		want    []Outcome
+		wantErr error
+	}{
+		{
+			name: "encoded strings",
+			json: `{"outcomes":"[\"Yes\", \"No\"]","outcomePrices":"[\"0.615\", \"0.385\"]","clobTokenIds":"[\"111\", \"222\"]"}`,
+			want: []Outcome{{Name: "Yes", Price: 0.615, TokenID: "111"}, {Name: "No", Price: 0.385, TokenID: "222"}},
+		},
+		{
+			name: "arrays without tokens",
+			json: `{"outcomes":["Up","Down"],"outcomePrices":["1","0"]}`,
+			want: []Outcome{{Name: "Up", Price: 1}, {Name: "Down", Price: 0}},
+		},
+		{
+			name: "no prices",
+			json: `{"outcomes":["Yes","No"],"outcomePrices":null,"clobTokenIds":""}`,
+			want: []Outcome{{Name: "Yes"}, {Name: "No"}},
+		},
+		{
+			name:    "price count mismatch",
+			json:    `{"outcomes":["Yes","No"],"outcomePrices":["0.5"]}`,
+			wantErr: ErrOutcomeMismatch,
+		},
+		{
+			name:    "token count mismatch",
+			json:    `{"outcomes":["Yes","No"],"clobTokenIds":"[\"111\"]"}`,
+			wantErr: ErrOutcomeMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var market Market
+			if err := json.Unmarshal([]byte(tt.json), &market); err != nil {
+				t.Fatalf("Failed to unmarshal: %v", err)
+			}
+
+			got, err := market.OutcomeList()
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("OutcomeList failed: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("OutcomeList = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarketStringListAccessors(t *testing.T) {
+	market := Market{
+		ClobTokenIDs:          `["1", "2"]`,
+		OutcomePrices:         StringOrArray{"0.5", " 0.25 "},
+		UMAResolutionStatuses: `["proposed", "disputed"]`,
+		PastSlugs:             "old-slug, older-slug",
+	}
+
+	if ids, err := market.TokenIDs(); err != nil || !reflect.DeepEqual(ids, []string{"1", "2"}) {
+		t.Errorf("TokenIDs = %v, %v", ids, err)
+	}
+	if prices, err := market.OutcomePricesFloat(); err != nil || !reflect.DeepEqual(prices, []float64{0.5, 0.25}) {
+		t.Errorf("OutcomePricesFloat = %v, %v", prices, err)
+	}
+	if statuses, err := market.UMAResolutionStatusList(); err != nil || !reflect.DeepEqual(statuses, []string{"proposed", "disputed"}) {
+		t.Errorf("UMAResolutionStatusList = %v, %v", statuses, err)
+	}
+	if slugs, err := market.PastSlugList(); err != nil || !reflect.DeepEqual(slugs, []string{"old-slug", "older-slug"}) {
+		t.Errorf("PastSlugList = %v, %v", slugs, err)
+	}
+
+	market.PastSlugs = `["a"]`
+	if slugs, err := market.PastSlugList(); err != nil || !reflect.DeepEqual(slugs, []string{"a"}) {
+		t.Errorf("PastSlugList from JSON = %v, %v", slugs, err)
+	}
+
+	var empty Market
+	if ids, err := empty.TokenIDs(); err != nil || len(ids) != 0 {
+		t.Errorf("empty TokenIDs = %v, %v", ids, err)
+	}
+
+	bad := Market{ClobTokenIDs: "[1, 2", OutcomePrices: StringOrArray{"n/a"}}
+	if _, err := bad.TokenIDs(); err == nil {
+		t.Error("expected error for malformed clobTokenIds")
+	}
+	if _, err := bad.OutcomePricesFloat(); err == nil {
+		t.Error("expected error for non-numeric price")
+	}
+}
@@ -0,0 +1,282 @@
+package polymarketgamma
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"strconv"
+)
+
+// DefaultPageSize is the page size used by pagination iterators when params.Limit is not set
+const DefaultPageSize = 100
+
+// PageOption configures pagination iterators such as AllMarkets and AllEvents
+type PageOption func(*pageConfig)
+
+type pageConfig struct {
+	pageSize    int
+	maxItems    int
+	concurrency int
+	progress    func(PageProgress)
+}
+
+// PageProgress describes the state of a pagination iterator after a page has been consumed
+type PageProgress struct {
+	Page       int // 1-based number of the page just consumed
+	Offset     int // Offset the page was requested at
+	PageItems  int // Number of items returned in the page
+	Items      int // Number of unique items yielded so far
+	Duplicates int // Number of items skipped so far because their ID was already yielded
+}
+
+// WithPageSize sets the number of items requested per page (overridden by params.Limit when set)
+func WithPageSize(size int) PageOption {
+	return func(cfg *pageConfig) {
+		cfg.pageSize = size
+	}
+}
+
+// WithMaxItems stops iteration after n items in total (0 = no limit)
+func WithMaxItems(n int) PageOption {
+	return func(cfg *pageConfig) {
+		cfg.maxItems = n
+	}
+}
+
+// WithConcurrency prefetches up to n pages in parallel. Items are still yielded in offset order.
+// Concurrent pages are requested at fixed offsets, so the page size must not exceed the server's maximum limit.
+func WithConcurrency(n int) PageOption {
+	return func(cfg *pageConfig) {
+		cfg.concurrency = n
+	}
+}
+
+// WithProgress registers a callback invoked after each page is consumed
+func WithProgress(fn func(PageProgress)) PageOption {
+	return func(cfg *pageConfig) {
+		cfg.progress = fn
+	}
+}
+
+func newPageConfig(limit int, opts []PageOption) pageConfig {
+	cfg := pageConfig{pageSize: DefaultPageSize, concurrency: 1}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if limit > 0 {
+		cfg.pageSize = limit
+	}
+	if cfg.pageSize <= 0 {
+		cfg.pageSize = DefaultPageSize
+	}
+	if cfg.concurrency < 1 {
+		cfg.concurrency = 1
+	}
+	return cfg
+}
+
+type pageResult[T any] struct {
+	offset int
+	items  []T
+	err    error
+}
+
+// paginate walks offset-based pages starting at startOffset until an empty page is returned,
+// a page holds only items already yielded (the server ignored offset or keeps repeating itself),
+// the item cap is reached, or fetch fails. Errors are yielded once and end the iteration, except
+// *PartialDecodeError, which is yielded before the page's decoded items and does not stop the scan.
+// Up to cfg.concurrency pages are fetched ahead of the consumer; items are yielded in offset
+// order and de-duplicated by idOf, since pages can shift when items are inserted mid-scan;
+// items with an empty ID are always yielded.
+func paginate[T any](ctx context.Context, startOffset int, cfg pageConfig, idOf func(T) string, fetch func(ctx context.Context, limit, offset int) ([]T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		nextOffset := startOffset
+		var inflight []chan pageResult[T]
+
+		startPage := func() {
+			offset := nextOffset
+			nextOffset += cfg.pageSize
+
+			ch := make(chan pageResult[T], 1)
+			go func() {
+				items, err := fetch(ctx, cfg.pageSize, offset)
+				ch <- pageResult[T]{offset: offset, items: items, err: err}
+			}()
+			inflight = append(inflight, ch)
+		}
+
+		seen := make(map[string]struct{})
+		progress := PageProgress{}
+
+		for {
+			for len(inflight) < cfg.concurrency {
+				startPage()
+			}
+
+			res := <-inflight[0]
+			inflight = inflight[1:]
+
+			// Elements that failed to decode still count towards the page length
+			pageLen := len(res.items)
+			if res.err != nil {
+				var zero T
+				var partial *PartialDecodeError
+				if !errors.As(res.err, &partial) {
+					yield(zero, res.err)
+					return
+				}
+				if !yield(zero, res.err) {
+					return
+				}
+				pageLen = partial.Total
+			}
+
+			if pageLen == 0 {
+				return
+			}
+
+			progress.Page++
+			progress.Offset = res.offset
+			progress.PageItems = len(res.items)
+			duplicatesBefore := progress.Duplicates
+			newIDs := 0
+
+			for _, item := range res.items {
+				// Items without an ID can't be told apart, so they are never treated as duplicates
+				if id := idOf(item); id != "" {
+					if _, dup := seen[id]; dup {
+						progress.Duplicates++
+						continue
+					}
+					seen[id] = struct{}{}
+					newIDs++
+				}
+
+				if !yield(item, nil) {
+					return
+				}
+				progress.Items++
+				if cfg.maxItems > 0 && progress.Items >= cfg.maxItems {
+					if cfg.progress != nil {
+						cfg.progress(progress)
+					}
+					return
+				}
+			}
+
+			if cfg.progress != nil {
+				cfg.progress(progress)
+			}
+
+			// A page whose IDs were all yielded before means the scan is no longer advancing.
+			// Prefetched pages are abandoned and cancelled on return.
+			if newIDs == 0 && progress.Duplicates > duplicatesBefore {
+				return
+			}
+
+			// Sequential iteration follows the items actually returned, so a server-side
+			// limit cap smaller than the requested page size never skips items
+			if cfg.concurrency == 1 {
+				nextOffset = res.offset + pageLen
+			}
+		}
+	}
+}
+
+// AllMarkets returns an iterator over all markets matching params, fetching pages on demand.
+// params.Limit sets the page size and params.Offset the starting offset.
+func (c *Client) AllMarkets(ctx context.Context, params *GetMarketsParams, opts ...PageOption) iter.Seq2[*Market, error] {
+	var base GetMarketsParams
+	if params != nil {
+		base = *params
+	}
+	cfg := newPageConfig(base.Limit, opts)
+
+	idOf := func(m *Market) string { return m.ID }
+
+	return paginate(ctx, base.Offset, cfg, idOf, func(ctx context.Context, limit, offset int) ([]*Market, error) {
+		page := base
+		page.Limit = limit
+		page.Offset = offset
+		return c.GetMarkets(ctx, &page)
+	})
+}
+
+// AllEvents returns an iterator over all events matching params, fetching pages on demand.
+// params.Limit sets the page size and params.Offset the starting offset.
+func (c *Client) AllEvents(ctx context.Context, params *GetEventsParams, opts ...PageOption) iter.Seq2[Event, error] {
+	var base GetEventsParams
+	if params != nil {
+		base = *params
+	}
+	cfg := newPageConfig(base.Limit, opts)
+
+	idOf := func(e Event) string { return e.ID }
+
+	return paginate(ctx, base.Offset, cfg, idOf, func(ctx context.Context, limit, offset int) ([]Event, error) {
+		page := base
+		page.Limit = limit
+		page.Offset = offset
+		return c.GetEvents(ctx, &page)
+	})
+}
+
+// AllSeries returns an iterator over all series matching params, fetching pages on demand.
+// params.Limit sets the page size and params.Offset the starting offset.
+func (c *Client) AllSeries(ctx context.Context, params *GetSeriesParams, opts ...PageOption) iter.Seq2[Series, error] {
+	var base GetSeriesParams
+	if params != nil {
+		base = *params
+	}
+	cfg := newPageConfig(base.Limit, opts)
+
+	idOf := func(s Series) string { return s.ID }
+
+	return paginate(ctx, base.Offset, cfg, idOf, func(ctx context.Context, limit, offset int) ([]Series, error) {
+		page := base
+		page.Limit = limit
+		page.Offset = offset
+		return c.GetSeries(ctx, &page)
+	})
+}
+
+// AllTags returns an iterator over all tags matching params, fetching pages on demand.
+// params.Limit sets the page size and params.Offset the starting offset.
+func (c *Client) AllTags(ctx context.Context, params *GetTagsParams, opts ...PageOption) iter.Seq2[Tag, error] {
+	var base GetTagsParams
+	if params != nil {
+		base = *params
+	}
+	cfg := newPageConfig(base.Limit, opts)
+
+	idOf := func(t Tag) string { return t.ID }
+
+	return paginate(ctx, base.Offset, cfg, idOf, func(ctx context.Context, limit, offset int) ([]Tag, error) {
+		page := base
+		page.Limit = limit
+		page.Offset = offset
+		return c.GetTags(ctx, &page)
+	})
+}
+
+// AllTeams returns an iterator over all teams matching params, fetching pages on demand.
+// params.Limit sets the page size and params.Offset the starting offset.
+func (c *Client) AllTeams(ctx context.Context, params *GetTeamsParams, opts ...PageOption) iter.Seq2[Team, error] {
+	var base GetTeamsParams
+	if params != nil {
+		base = *params
+	}
+	cfg := newPageConfig(base.Limit, opts)
+
+	idOf := func(t Team) string { return strconv.Itoa(t.ID) }
+
+	return paginate(ctx, base.Offset, cfg, idOf, func(ctx context.Context, limit, offset int) ([]Team, error) {
+		page := base
+		page.Limit = limit
+		page.Offset = offset
+		return c.GetTeams(ctx, &page)
+	})
+}
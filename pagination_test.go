@@ -0,0 +1,359 @@
+package polymarketgamma
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newPagedServer serves total numbered items from every list endpoint honoring limit and offset
+func newPagedServer(t *testing.T, total int, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests != nil {
+			requests.Add(1)
+		}
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+		items := []map[string]any{}
+		for i := offset; i < total && i < offset+limit; i++ {
+			if r.URL.Path == "/teams" {
+				items = append(items, map[string]any{"id": i})
+			} else {
+				items = append(items, map[string]any{"id": fmt.Sprintf("%d", i)})
+			}
+		}
+		json.NewEncoder(w).Encode(items)
+	}))
+}
+
+func TestAllMarkets(t *testing.T) {
+	var requests atomic.Int32
+	server := newPagedServer(t, 25, &requests)
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL))
+	closed := false
+
+	var ids []string
+	for market, err := range client.AllMarkets(context.Background(), &GetMarketsParams{Limit: 10, Closed: &closed}) {
+		if err != nil {
+			t.Fatalf("AllMarkets failed: %v", err)
+		}
+		ids = append(ids, market.ID)
+	}
+
+	if len(ids) != 25 {
+		t.Fatalf("got %d markets, want 25", len(ids))
+	}
+	for i, id := range ids {
+		if id != strconv.Itoa(i) {
+			t.Fatalf("ids[%d] = %s, want %d", i, id, i)
+		}
+	}
+	// 3 full/partial pages plus the terminating empty page
+	if requests.Load() != 4 {
+		t.Errorf("requests = %d, want 4", requests.Load())
+	}
+}
+
+func TestAllEventsMaxItems(t *testing.T) {
+	var requests atomic.Int32
+	server := newPagedServer(t, 1000, &requests)
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL))
+
+	count := 0
+	for _, err := range client.AllEvents(context.Background(), nil, WithPageSize(20), WithMaxItems(45)) {
+		if err != nil {
+			t.Fatalf("AllEvents failed: %v", err)
+		}
+		count++
+	}
+
+	if count != 45 {
+		t.Errorf("count = %d, want 45", count)
+	}
+	if requests.Load() != 3 {
+		t.Errorf("requests = %d, want 3", requests.Load())
+	}
+}
+
+func TestAllSeriesTagsTeams(t *testing.T) {
+	server := newPagedServer(t, 7, nil)
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	count := 0
+	for _, err := range client.AllSeries(ctx, &GetSeriesParams{Limit: 3}) {
+		if err != nil {
+			t.Fatalf("AllSeries failed: %v", err)
+		}
+		count++
+	}
+	if count != 7 {
+		t.Errorf("series count = %d, want 7", count)
+	}
+
+	count = 0
+	for _, err := range client.AllTags(ctx, &GetTagsParams{Limit: 3, Offset: 2}) {
+		if err != nil {
+			t.Fatalf("AllTags failed: %v", err)
+		}
+		count++
+	}
+	if count != 5 {
+		t.Errorf("tags count = %d, want 5", count)
+	}
+
+	count = 0
+	for _, err := range client.AllTeams(ctx, &GetTeamsParams{Limit: 4}) {
+		if err != nil {
+			t.Fatalf("AllTeams failed: %v", err)
+		}
+		count++
+	}
+	if count != 7 {
+		t.Errorf("teams count = %d, want 7", count)
+	}
+}
+
+func TestAllMarketsPropagatesErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") != "" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`[{"id":"1"},{"id":"2"}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL))
+
+	var got []string
+	var lastErr error
+	for market, err := range client.AllMarkets(context.Background(), &GetMarketsParams{Limit: 2}) {
+		if err != nil {
+			lastErr = err
+			break
+		}
+		got = append(got, market.ID)
+	}
+
+	if len(got) != 2 {
+		t.Errorf("got %d markets before the error, want 2", len(got))
+	}
+	if !IsServerError(lastErr) {
+		t.Errorf("expected server error, got %v", lastErr)
+	}
+}
+
+func TestAllMarketsEarlyBreak(t *testing.T) {
+	var requests atomic.Int32
+	server := newPagedServer(t, 100, &requests)
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL))
+	for market, err := range client.AllMarkets(context.Background(), &GetMarketsParams{Limit: 10}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if market.ID == "3" {
+			break
+		}
+	}
+	if requests.Load() != 1 {
+		t.Errorf("requests = %d, want 1", requests.Load())
+	}
+}
+
+func TestAllMarketsConcurrent(t *testing.T) {
+	var inflight, maxInflight atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inflight.Add(1)
+		defer inflight.Add(-1)
+		for {
+			m := maxInflight.Load()
+			if n <= m || maxInflight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		// Later pages respond faster to shake out ordering bugs
+		time.Sleep(time.Duration(200-offset) * 100 * time.Microsecond)
+
+		items := []map[string]any{}
+		for i := offset; i < 137 && i < offset+limit; i++ {
+			items = append(items, map[string]any{"id": fmt.Sprintf("%d", i)})
+		}
+		json.NewEncoder(w).Encode(items)
+	}))
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL))
+
+	var pages []PageProgress
+	var ids []string
+	for market, err := range client.AllMarkets(context.Background(), &GetMarketsParams{Limit: 10},
+		WithConcurrency(4),
+		WithProgress(func(p PageProgress) { pages = append(pages, p) }),
+	) {
+		if err != nil {
+			t.Fatalf("AllMarkets failed: %v", err)
+		}
+		ids = append(ids, market.ID)
+	}
+
+	if len(ids) != 137 {
+		t.Fatalf("got %d markets, want 137", len(ids))
+	}
+	for i, id := range ids {
+		if id != strconv.Itoa(i) {
+			t.Fatalf("ids[%d] = %s, want %d (order must be stable)", i, id, i)
+		}
+	}
+	if maxInflight.Load() < 2 || maxInflight.Load() > 4 {
+		t.Errorf("max in-flight requests = %d, want between 2 and 4", maxInflight.Load())
+	}
+	if len(pages) != 14 {
+		t.Fatalf("progress called %d times, want 14", len(pages))
+	}
+	last := pages[len(pages)-1]
+	if last.Page != 14 || last.Offset != 130 || last.PageItems != 7 || last.Items != 137 {
+		t.Errorf("unexpected final progress: %+v", last)
+	}
+}
+
+func TestAllMarketsDeduplicates(t *testing.T) {
+	// Simulates a market inserted at the head of the list between page requests:
+	// the second page repeats the last item of the first page
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("offset") {
+		case "":
+			w.Write([]byte(`[{"id":"a"},{"id":"b"}]`))
+		case "2":
+			w.Write([]byte(`[{"id":"b"},{"id":"c"}]`))
+		default:
+			w.Write([]byte(`[]`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL))
+
+	var last PageProgress
+	var ids []string
+	for market, err := range client.AllMarkets(context.Background(), &GetMarketsParams{Limit: 2},
+		WithConcurrency(3),
+		WithProgress(func(p PageProgress) { last = p }),
+	) {
+		if err != nil {
+			t.Fatalf("AllMarkets failed: %v", err)
+		}
+		ids = append(ids, market.ID)
+	}
+
+	if fmt.Sprint(ids) != "[a b c]" {
+		t.Errorf("ids = %v, want [a b c]", ids)
+	}
+	if last.Duplicates != 1 {
+		t.Errorf("Duplicates = %d, want 1", last.Duplicates)
+	}
+}
+
+func TestAllMarketsStopsOnRepeatedPage(t *testing.T) {
+	// A server that ignores offset returns the same page forever
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Write([]byte(`[{"id":"a"},{"id":"b"}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL))
+
+	var last PageProgress
+	var ids []string
+	for market, err := range client.AllMarkets(context.Background(), &GetMarketsParams{Limit: 2},
+		WithProgress(func(p PageProgress) { last = p }),
+	) {
+		if err != nil {
+			t.Fatalf("AllMarkets failed: %v", err)
+		}
+		ids = append(ids, market.ID)
+	}
+
+	if fmt.Sprint(ids) != "[a b]" {
+		t.Errorf("ids = %v, want [a b]", ids)
+	}
+	if requests.Load() != 2 || last.Duplicates != 2 {
+		t.Errorf("requests = %d, duplicates = %d, want 2 and 2", requests.Load(), last.Duplicates)
+	}
+}
+
+func TestAllMarketsEmptyIDs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") == "" {
+			w.Write([]byte(`[{"id":""},{"id":"a"},{"id":""}]`))
+			return
+		}
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL))
+
+	var last PageProgress
+	var count int
+	for _, err := range client.AllMarkets(context.Background(), &GetMarketsParams{Limit: 3},
+		WithProgress(func(p PageProgress) { last = p }),
+	) {
+		if err != nil {
+			t.Fatalf("AllMarkets failed: %v", err)
+		}
+		count++
+	}
+
+	if count != 3 || last.Duplicates != 0 {
+		t.Errorf("yielded %d markets with %d duplicates, want 3 and 0", count, last.Duplicates)
+	}
+}
+
+func TestAllMarketsConcurrentStopsOnRepeatedPage(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Write([]byte(`[{"id":"a"},{"id":"b"}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL))
+
+	var ids []string
+	for market, err := range client.AllMarkets(context.Background(), &GetMarketsParams{Limit: 2}, WithConcurrency(4)) {
+		if err != nil {
+			t.Fatalf("AllMarkets failed: %v", err)
+		}
+		ids = append(ids, market.ID)
+	}
+
+	if fmt.Sprint(ids) != "[a b]" {
+		t.Errorf("ids = %v, want [a b]", ids)
+	}
+	// The first two pages plus at most the prefetch window
+	if n := requests.Load(); n > 5 {
+		t.Errorf("requests = %d, want the scan to stop after the first repeated page", n)
+	}
+}
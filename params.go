@@ -0,0 +1,327 @@
+package polymarketgamma
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Params structs are encoded to and from query strings using their json tag names:
+//   - strings and enums are omitted when empty, plain ints when not positive
+//   - pointers are omitted when nil, so an explicit false or 0 is still sent
+//   - slices are sent as repeated parameters
+//   - *NormalizedTime is formatted as RFC 3339 with fractional seconds, so it parses back exactly
+
+var normalizedTimeType = reflect.TypeOf(NormalizedTime{})
+
+// paramName returns the query parameter name of a struct field, or "" if it is not encoded
+func paramName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" || !field.IsExported() {
+		return ""
+	}
+	return name
+}
+
+// paramValues encodes params for a Values method. encodeParams only fails on field types that no
+// params struct uses (TestParamsEncodeAllTypes checks each one), so the error is dropped.
+func paramValues(p any) url.Values {
+	values, _ := encodeParams(p)
+	return values
+}
+
+// encodeParams encodes the struct pointed to by p into query values.
+// Fields of unsupported types are skipped and reported in the error.
+func encodeParams(p any) (url.Values, error) {
+	values := url.Values{}
+
+	v := reflect.ValueOf(p)
+	if v.Kind() != reflect.Pointer || v.Type().Elem().Kind() != reflect.Struct {
+		return values, fmt.Errorf("params must be a struct pointer, got %T", p)
+	}
+	if v.IsNil() {
+		return values, nil
+	}
+	v = v.Elem()
+
+	var errs []error
+	add := func(name string, field reflect.Value) {
+		s, err := formatParam(field)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("parameter %q: %w", name, err))
+			return
+		}
+		values.Add(name, s)
+	}
+
+	for i := range v.NumField() {
+		name := paramName(v.Type().Field(i))
+		if name == "" {
+			continue
+		}
+
+		field := v.Field(i)
+		switch field.Kind() {
+		case reflect.Pointer:
+			if !field.IsNil() {
+				add(name, field.Elem())
+			}
+		case reflect.Slice:
+			for j := range field.Len() {
+				add(name, field.Index(j))
+			}
+		case reflect.Int:
+			if field.Int() > 0 {
+				add(name, field)
+			}
+		default:
+			if !field.IsZero() {
+				add(name, field)
+			}
+		}
+	}
+
+	return values, errors.Join(errs...)
+}
+
+func formatParam(v reflect.Value) (string, error) {
+	if v.Type() == normalizedTimeType {
+		return v.Interface().(NormalizedTime).Time().Format(time.RFC3339Nano), nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Int:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	}
+	return "", fmt.Errorf("unsupported type %s", v.Type())
+}
+
+// decodeParams decodes query values into the struct pointed to by p.
+// Unknown parameters and repeated scalar parameters are errors.
+func decodeParams(values url.Values, p any) error {
+	v := reflect.ValueOf(p)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("params must be a non-nil struct pointer, got %T", p)
+	}
+	v = v.Elem()
+
+	fields := make(map[string]reflect.Value, v.NumField())
+	for i := range v.NumField() {
+		if name := paramName(v.Type().Field(i)); name != "" {
+			fields[name] = v.Field(i)
+		}
+	}
+
+	for name, vals := range values {
+		field, ok := fields[name]
+		if !ok {
+			return fmt.Errorf("unknown parameter %q", name)
+		}
+
+		if field.Kind() == reflect.Slice {
+			slice := reflect.MakeSlice(field.Type(), len(vals), len(vals))
+			for i, s := range vals {
+				if err := parseParam(slice.Index(i), s); err != nil {
+					return fmt.Errorf("parameter %q: %w", name, err)
+				}
+			}
+			field.Set(slice)
+			continue
+		}
+
+		if len(vals) != 1 {
+			return fmt.Errorf("parameter %q given %d times", name, len(vals))
+		}
+
+		target := field
+		if field.Kind() == reflect.Pointer {
+			target = reflect.New(field.Type().Elem()).Elem()
+		}
+		if err := parseParam(target, vals[0]); err != nil {
+			return fmt.Errorf("parameter %q: %w", name, err)
+		}
+		if field.Kind() == reflect.Pointer {
+			field.Set(target.Addr())
+		}
+	}
+
+	return nil
+}
+
+func parseParam(v reflect.Value, s string) error {
+	if v.Type() == normalizedTimeType {
+		for _, layout := range []string{time.RFC3339Nano, time.DateOnly} {
+			if t, err := time.Parse(layout, s); err == nil {
+				v.Set(reflect.ValueOf(NormalizedTime(t)))
+				return nil
+			}
+		}
+
+		// Fall back to the formats accepted in API responses
+		var t NormalizedTime
+		quoted, _ := json.Marshal(s)
+		if err := t.UnmarshalJSON(quoted); err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(t))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Int:
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(n))
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
+
+// parseParams decodes query values into a new T
+func parseParams[T any](values url.Values) (*T, error) {
+	p := new(T)
+	if err := decodeParams(values, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// queryString returns "?" followed by the encoded values, or "" if there are none
+func queryString(values url.Values) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return "?" + values.Encode()
+}
+
+// Values encodes the params as query values
+func (p *GetMarketsParams) Values() url.Values { return paramValues(p) }
+
+// Values encodes the params as query values
+func (p *GetEventsParams) Values() url.Values { return paramValues(p) }
+
+// Values encodes the params as query values
+func (p *GetSeriesParams) Values() url.Values { return paramValues(p) }
+
+// Values encodes the params as query values
+func (p *GetTagsParams) Values() url.Values { return paramValues(p) }
+
+// Values encodes the params as query values
+func (p *GetTeamsParams) Values() url.Values { return paramValues(p) }
+
+// Values encodes the params as query values
+func (p *SearchParams) Values() url.Values { return paramValues(p) }
+
+// Values encodes the params as query values
+func (p *GetRelatedTagsParams) Values() url.Values { return paramValues(p) }
+
+// Values encodes the params as query values
+func (p *GetMarketByIDQueryParams) Values() url.Values { return paramValues(p) }
+
+// Values encodes the params as query values
+func (p *GetEventByIDQueryParams) Values() url.Values { return paramValues(p) }
+
+// Values encodes the params as query values
+func (p *GetEventBySlugQueryParams) Values() url.Values { return paramValues(p) }
+
+// Values encodes the params as query values
+func (p *GetSeriesByIDQueryParams) Values() url.Values { return paramValues(p) }
+
+// Values encodes the params as query values
+func (p *GetTagByIDQueryParams) Values() url.Values { return paramValues(p) }
+
+// Values encodes the params as query values
+func (p *GetTagBySlugQueryParams) Values() url.Values { return paramValues(p) }
+
+// ParseMarketsParams decodes query values, e.g. a saved screener query string, into GetMarketsParams
+func ParseMarketsParams(values url.Values) (*GetMarketsParams, error) {
+	return parseParams[GetMarketsParams](values)
+}
+
+// ParseEventsParams decodes query values into GetEventsParams
+func ParseEventsParams(values url.Values) (*GetEventsParams, error) {
+	return parseParams[GetEventsParams](values)
+}
+
+// ParseSeriesParams decodes query values into GetSeriesParams
+func ParseSeriesParams(values url.Values) (*GetSeriesParams, error) {
+	return parseParams[GetSeriesParams](values)
+}
+
+// ParseTagsParams decodes query values into GetTagsParams
+func ParseTagsParams(values url.Values) (*GetTagsParams, error) {
+	return parseParams[GetTagsParams](values)
+}
+
+// ParseTeamsParams decodes query values into GetTeamsParams
+func ParseTeamsParams(values url.Values) (*GetTeamsParams, error) {
+	return parseParams[GetTeamsParams](values)
+}
+
+// ParseSearchParams decodes query values into SearchParams
+func ParseSearchParams(values url.Values) (*SearchParams, error) {
+	return parseParams[SearchParams](values)
+}
+
+// ParseRelatedTagsParams decodes query values into GetRelatedTagsParams
+func ParseRelatedTagsParams(values url.Values) (*GetRelatedTagsParams, error) {
+	return parseParams[GetRelatedTagsParams](values)
+}
+
+// ParseMarketByIDQueryParams decodes query values into GetMarketByIDQueryParams
+func ParseMarketByIDQueryParams(values url.Values) (*GetMarketByIDQueryParams, error) {
+	return parseParams[GetMarketByIDQueryParams](values)
+}
+
+// ParseEventByIDQueryParams decodes query values into GetEventByIDQueryParams
+func ParseEventByIDQueryParams(values url.Values) (*GetEventByIDQueryParams, error) {
+	return parseParams[GetEventByIDQueryParams](values)
+}
+
+// ParseEventBySlugQueryParams decodes query values into GetEventBySlugQueryParams
+func ParseEventBySlugQueryParams(values url.Values) (*GetEventBySlugQueryParams, error) {
+	return parseParams[GetEventBySlugQueryParams](values)
+}
+
+// ParseSeriesByIDQueryParams decodes query values into GetSeriesByIDQueryParams
+func ParseSeriesByIDQueryParams(values url.Values) (*GetSeriesByIDQueryParams, error) {
+	return parseParams[GetSeriesByIDQueryParams](values)
+}
+
+// ParseTagByIDQueryParams decodes query values into GetTagByIDQueryParams
+func ParseTagByIDQueryParams(values url.Values) (*GetTagByIDQueryParams, error) {
+	return parseParams[GetTagByIDQueryParams](values)
+}
+
+// ParseTagBySlugQueryParams decodes query values into GetTagBySlugQueryParams
+func ParseTagBySlugQueryParams(values url.Values) (*GetTagBySlugQueryParams, error) {
+	return parseParams[GetTagBySlugQueryParams](values)
+}
@@ -0,0 +1,222 @@
+package polymarketgamma
+
+import (
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParamsRoundTrip(t *testing.T) {
+	yes, no := true, false
+	tag, page, one := 7, 2, 1
+	minLiquidity, maxVolume := 1000.5, 2e6
+	start := NormalizedTime(time.Date(2025, 3, 1, 12, 0, 0, 123456789, time.UTC))
+	end := NormalizedTime(time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name   string
+		params interface{ Values() url.Values }
+		parse  func(url.Values) (any, error)
+	}{
+		{
+			name: "markets",
+			params: &GetMarketsParams{
+				Limit: 100, Offset: 200, Order: "volume24hr,liquidityNum", Ascending: &no,
+				ID: []int{1, 2}, Slug: []string{"a", "b"}, ClobTokenIDs: []string{"t"}, ConditionIDs: []string{"0xc"},
+				MarketMakerAddress: []string{"0xm"}, LiquidityNumMin: &minLiquidity, VolumeNumMax: &maxVolume,
+				StartDateMin: &start, EndDateMax: &end, TagID: &tag, RelatedTags: &yes, CYOM: &no,
+				UMAResolutionStatus: UMAResolutionResolved, GameID: "g1", RewardsMinSize: &minLiquidity,
+				SportsMarketTypes: []SportsMarketType{SportsMarketSpreads, SportsMarketTotals}, QuestionIDs: []string{"q"},
+				IncludeTag: &yes, Closed: &no,
+			},
+			parse: func(v url.Values) (any, error) { return ParseMarketsParams(v) },
+		},
+		{
+			name: "events",
+			params: &GetEventsParams{
+				Limit: 10, Order: OrderStartDate, Ascending: &yes, ID: []int{3}, Slug: []string{"e"}, TagID: &tag,
+				ExcludeTagID: []int{8, 9}, RelatedTags: &yes, Featured: &yes, CYOM: &no, IncludeChat: &yes,
+				IncludeTemplate: &no, Recurrence: RecurrenceWeekly, Closed: &yes, StartDateMax: &start, EndDateMin: &end,
+			},
+			parse: func(v url.Values) (any, error) { return ParseEventsParams(v) },
+		},
+		{
+			name: "series",
+			params: &GetSeriesParams{
+				Limit: 5, Offset: 5, Order: OrderVolume, Slug: []string{"s"}, CategoriesIDs: []int{4},
+				CategoriesLabels: []string{"Sports"}, Closed: &no, IncludeChat: &yes, Recurrence: RecurrenceDaily,
+			},
+			parse: func(v url.Values) (any, error) { return ParseSeriesParams(v) },
+		},
+		{
+			name:   "tags",
+			params: &GetTagsParams{Limit: 50, Order: OrderLabel, Ascending: &yes, IncludeTemplate: &yes, IsCarousel: &no},
+			parse:  func(v url.Values) (any, error) { return ParseTagsParams(v) },
+		},
+		{
+			name: "teams",
+			params: &GetTeamsParams{
+				Limit: 20, Offset: 40, Order: OrderName, League: []string{"nba", "nfl"}, Name: []string{"Lakers"},
+				Abbreviation: []string{"LAL"},
+			},
+			parse: func(v url.Values) (any, error) { return ParseTeamsParams(v) },
+		},
+		{
+			name: "search",
+			params: &SearchParams{
+				Q: "election & more", Cache: &no, EventsStatus: EventsStatusActive, LimitPerType: &tag, Page: &page,
+				EventsTag: []string{"politics"}, KeepClosedMarkets: &one, Sort: OrderVolume, Ascending: &no,
+				SearchTags: &yes, SearchProfiles: &no, Recurrence: RecurrenceMonthly, ExcludeTagID: []int{1},
+				Optimized: &yes,
+			},
+			parse: func(v url.Values) (any, error) { return ParseSearchParams(v) },
+		},
+		{
+			name:   "related tags",
+			params: &GetRelatedTagsParams{OmitEmpty: &yes, Status: TagStatusClosed},
+			parse:  func(v url.Values) (any, error) { return ParseRelatedTagsParams(v) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Round-trip through the encoded query string, as a saved config would be
+			query, err := url.ParseQuery(tt.params.Values().Encode())
+			if err != nil {
+				t.Fatalf("ParseQuery failed: %v", err)
+			}
+			got, err := tt.parse(query)
+			if err != nil {
+				t.Fatalf("parse failed: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.params) {
+				t.Errorf("round trip mismatch\n got: %+v\nwant: %+v", got, tt.params)
+			}
+		})
+	}
+}
+
+func TestParamsValues(t *testing.T) {
+	no := false
+	minLiquidity := 1000.0
+	values := (&GetMarketsParams{Limit: -1, Closed: &no, LiquidityNumMin: &minLiquidity, Slug: []string{"a", "b"}}).Values()
+
+	if got := values.Encode(); got != "closed=false&liquidity_num_min=1000&slug=a&slug=b" {
+		t.Errorf("Encode = %q", got)
+	}
+
+	var nilParams *GetEventsParams
+	if len(nilParams.Values()) != 0 {
+		t.Errorf("nil params encoded to %v", nilParams.Values())
+	}
+}
+
+func TestParamsEncodeAllTypes(t *testing.T) {
+	for _, p := range []any{
+		&GetMarketsParams{}, &GetEventsParams{}, &GetSeriesParams{}, &GetTagsParams{}, &GetTeamsParams{},
+		&SearchParams{}, &GetRelatedTagsParams{}, &GetMarketByIDQueryParams{}, &GetEventByIDQueryParams{},
+		&GetEventBySlugQueryParams{}, &GetSeriesByIDQueryParams{}, &GetTagByIDQueryParams{}, &GetTagBySlugQueryParams{},
+	} {
+		fillParams(reflect.ValueOf(p).Elem())
+		if _, err := encodeParams(p); err != nil {
+			t.Errorf("%T: %v", p, err)
+		}
+	}
+}
+
+// fillParams sets every field of a params struct to a non-zero value, so each one gets encoded
+func fillParams(v reflect.Value) {
+	for i := range v.NumField() {
+		field := v.Field(i)
+		switch field.Kind() {
+		case reflect.Pointer:
+			field.Set(reflect.New(field.Type().Elem()))
+			fillValue(field.Elem())
+		case reflect.Slice:
+			field.Set(reflect.MakeSlice(field.Type(), 1, 1))
+			fillValue(field.Index(0))
+		default:
+			fillValue(field)
+		}
+	}
+}
+
+func fillValue(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString("x")
+	case reflect.Int:
+		v.SetInt(1)
+	case reflect.Float64:
+		v.SetFloat(1.5)
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Struct:
+		if v.Type() == normalizedTimeType {
+			v.Set(reflect.ValueOf(NormalizedTime(time.Now())))
+		}
+	}
+}
+
+func TestParamsUnsupportedTypes(t *testing.T) {
+	type unsupported struct {
+		Limit int     `json:"limit"`
+		Count uint    `json:"count"`
+		Ratio float32 `json:"ratio"`
+	}
+
+	values, err := encodeParams(&unsupported{Limit: 5, Count: 1, Ratio: 0.5})
+	if err == nil || !strings.Contains(err.Error(), `parameter "count"`) || !strings.Contains(err.Error(), `parameter "ratio"`) {
+		t.Errorf("encode error = %v, want both unsupported fields reported", err)
+	}
+	if values.Get("limit") != "5" {
+		t.Errorf("values = %v, want supported fields still encoded", values)
+	}
+
+	if err := decodeParams(url.Values{"count": {"1"}}, &unsupported{}); err == nil {
+		t.Error("decode into uint succeeded")
+	}
+	if err := decodeParams(url.Values{}, unsupported{}); err == nil {
+		t.Error("decode into non-pointer succeeded")
+	}
+	if _, err := encodeParams(unsupported{}); err == nil {
+		t.Error("encode of non-pointer succeeded")
+	}
+}
+
+func TestParseParamsErrors(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"limit=abc", `parameter "limit"`},
+		{"closed=maybe", `parameter "closed"`},
+		{"limit=1&limit=2", `parameter "limit" given 2 times`},
+		{"liquidity_min=5", `unknown parameter "liquidity_min"`},
+		{"end_date_min=yesterday", `parameter "end_date_min"`},
+	}
+
+	for _, tt := range tests {
+		values, _ := url.ParseQuery(tt.query)
+		_, err := ParseMarketsParams(values)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("ParseMarketsParams(%q) error = %v, want %q", tt.query, err, tt.want)
+		}
+	}
+}
+
+func TestParseSavedQuery(t *testing.T) {
+	values, _ := url.ParseQuery("closed=false&order=volume24hr&ascending=false&liquidity_num_min=1000&end_date_max=2025-12-31")
+	params, err := ParseMarketsParams(values)
+	if err != nil {
+		t.Fatalf("ParseMarketsParams failed: %v", err)
+	}
+	if params.Closed == nil || *params.Closed || params.Order != OrderVolume24hr || *params.LiquidityNumMin != 1000 {
+		t.Errorf("params = %+v", params)
+	}
+	if !params.EndDateMax.Time().Equal(time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("EndDateMax = %v", params.EndDateMax)
+	}
+}
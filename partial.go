@@ -0,0 +1,94 @@
+package polymarketgamma
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ElementDecodeError describes one list element that failed to decode
+type ElementDecodeError struct {
+	Index int             // Position of the element in the response array
+	Raw   json.RawMessage // Offending element JSON
+	Err   error           // Decoding error
+}
+
+// Error implements the error interface
+func (e *ElementDecodeError) Error() string {
+	return fmt.Sprintf("element %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the underlying decoding error
+func (e *ElementDecodeError) Unwrap() error {
+	return e.Err
+}
+
+// PartialDecodeError is returned alongside the successfully decoded items when partial decoding
+// is enabled and some elements of a list response could not be decoded
+type PartialDecodeError struct {
+	Path     string               // Request path including the query string
+	Total    int                  // Number of elements in the response array
+	Elements []ElementDecodeError // Failed elements in response order
+}
+
+// Error implements the error interface
+func (e *PartialDecodeError) Error() string {
+	return fmt.Sprintf("failed to decode %d of %d elements from %s: %v", len(e.Elements), e.Total, e.Path, &e.Elements[0])
+}
+
+// Unwrap returns the per-element errors
+func (e *PartialDecodeError) Unwrap() []error {
+	errs := make([]error, len(e.Elements))
+	for i := range e.Elements {
+		errs[i] = &e.Elements[i]
+	}
+	return errs
+}
+
+// WithPartialDecoding makes GetMarkets, GetEvents, GetSeries, GetTags and GetTeams decode list responses
+// element by element. Elements that fail to decode are skipped: the call returns the other items together
+// with a *PartialDecodeError listing each failed index and its raw JSON.
+func WithPartialDecoding() Option {
+	return func(c *Client) {
+		c.partialDecoding = true
+	}
+}
+
+// IsPartialDecode reports whether err is a *PartialDecodeError, in which case the items returned with it are usable
+func IsPartialDecode(err error) bool {
+	var partial *PartialDecodeError
+	return errors.As(err, &partial)
+}
+
+// decodeList decodes a JSON array response. With partial decoding enabled, elements are decoded one at
+// a time and failures are collected into a *PartialDecodeError returned with the decoded items.
+func decodeList[T any](c *Client, path string, body []byte) ([]T, error) {
+	if !c.partialDecoding {
+		var items []T
+		if err := c.decode(path, body, &items); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+		return items, nil
+	}
+
+	var elements []json.RawMessage
+	if err := json.Unmarshal(body, &elements); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	items := make([]T, 0, len(elements))
+	var failed []ElementDecodeError
+	for i, raw := range elements {
+		var item T
+		if err := c.decode(path, raw, &item); err != nil {
+			failed = append(failed, ElementDecodeError{Index: i, Raw: raw, Err: err})
+			continue
+		}
+		items = append(items, item)
+	}
+
+	if len(failed) > 0 {
+		return items, &PartialDecodeError{Path: path, Total: len(elements), Elements: failed}
+	}
+	return items, nil
+}
@@ -0,0 +1,117 @@
+package polymarketgamma
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const partialMarketsPage = `[
+	{"id":"1","question":"ok"},
+	{"id":"2","endDate":"not a date"},
+	{"id":"3","question":"ok"},
+	{"id":"4","active":"maybe"}
+]`
+
+func TestPartialDecoding(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(partialMarketsPage))
+	}))
+	defer server.Close()
+	ctx := context.Background()
+
+	// Default mode: one bad element fails the whole page
+	strict := NewClient(nil, WithBaseURL(server.URL))
+	if markets, err := strict.GetMarkets(ctx, nil); err == nil || markets != nil {
+		t.Fatalf("GetMarkets = %v, %v; want error and no markets", markets, err)
+	}
+
+	client := NewClient(nil, WithBaseURL(server.URL), WithPartialDecoding())
+	markets, err := client.GetMarkets(ctx, nil)
+	if !IsPartialDecode(err) {
+		t.Fatalf("error = %v, want *PartialDecodeError", err)
+	}
+	if len(markets) != 2 || markets[0].ID != "1" || markets[1].ID != "3" {
+		t.Fatalf("markets = %+v, want IDs 1 and 3", markets)
+	}
+
+	var partial *PartialDecodeError
+	errors.As(err, &partial)
+	if partial.Total != 4 || len(partial.Elements) != 2 {
+		t.Fatalf("partial = %+v", partial)
+	}
+	if partial.Elements[0].Index != 1 || partial.Elements[1].Index != 3 {
+		t.Errorf("failed indexes = %d, %d, want 1, 3", partial.Elements[0].Index, partial.Elements[1].Index)
+	}
+	if string(partial.Elements[1].Raw) != `{"id":"4","active":"maybe"}` {
+		t.Errorf("raw = %s", partial.Elements[1].Raw)
+	}
+}
+
+func TestPartialDecodingAllEndpoints(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/teams" {
+			w.Write([]byte(`[{"id":1},{"id":"x"}]`))
+			return
+		}
+		w.Write([]byte(`[{"id":"1"},{"id":2}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL), WithPartialDecoding())
+	ctx := context.Background()
+
+	check := func(name string, n int, err error) {
+		t.Helper()
+		if !IsPartialDecode(err) || n != 1 {
+			t.Errorf("%s: %d items, err %v; want 1 item and a partial decode error", name, n, err)
+		}
+	}
+
+	events, err := client.GetEvents(ctx, nil)
+	check("GetEvents", len(events), err)
+	series, err := client.GetSeries(ctx, nil)
+	check("GetSeries", len(series), err)
+	tags, err := client.GetTags(ctx, nil)
+	check("GetTags", len(tags), err)
+	teams, err := client.GetTeams(ctx, nil)
+	check("GetTeams", len(teams), err)
+}
+
+func TestPartialDecodingPagination(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("offset") {
+		case "", "0":
+			w.Write([]byte(`[{"id":"1"},{"id":"2","closed":"nope"}]`))
+		case "2":
+			w.Write([]byte(`[{"id":"3"}]`))
+		default:
+			w.Write([]byte(`[]`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL), WithPartialDecoding())
+
+	var ids []string
+	var partials int
+	for market, err := range client.AllMarkets(context.Background(), &GetMarketsParams{Limit: 2}) {
+		if err != nil {
+			if !IsPartialDecode(err) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			partials++
+			continue
+		}
+		ids = append(ids, market.ID)
+	}
+
+	if partials != 1 {
+		t.Errorf("partial errors = %d, want 1", partials)
+	}
+	if len(ids) != 2 || ids[0] != "1" || ids[1] != "3" {
+		t.Errorf("ids = %v, want [1 3]", ids)
+	}
+}
@@ -0,0 +1,504 @@
+package polymarketgamma
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ErrInvalidQuery is returned by query builders when the accumulated filters are inconsistent
+var ErrInvalidQuery = errors.New("invalid query")
+
+// SortDirection is the direction of an OrderBy clause
+type SortDirection bool
+
+const (
+	Asc  SortDirection = true
+	Desc SortDirection = false
+)
+
+// queryBuilder holds the state shared by the fluent query builders
+type queryBuilder struct {
+	errs      []error
+	order     []string
+	direction *SortDirection
+	closed    *bool
+}
+
+func (b *queryBuilder) fail(format string, args ...any) {
+	b.errs = append(b.errs, fmt.Errorf(format, args...))
+}
+
+func (b *queryBuilder) orderBy(field OrderField, dir SortDirection) {
+	if field == "" {
+		b.fail("empty order field")
+		return
+	}
+	if !field.IsValid() {
+		b.fail("unknown order field %q", field)
+		return
+	}
+	if b.direction != nil && *b.direction != dir {
+		b.fail("conflicting sort directions for %q: the API applies a single direction to all order fields", field)
+		return
+	}
+	b.order = append(b.order, string(field))
+	b.direction = &dir
+}
+
+func (b *queryBuilder) setClosed(closed bool) {
+	if b.closed != nil && *b.closed != closed {
+		b.fail("Open and Closed are mutually exclusive")
+		return
+	}
+	b.closed = &closed
+}
+
+// result applies the shared state and returns the accumulated validation errors
+func (b *queryBuilder) result(order *OrderField, ascending, closed **bool) error {
+	if len(b.order) > 0 {
+		*order = OrderField(strings.Join(b.order, ","))
+		*ascending = ptr(bool(*b.direction))
+	}
+	if b.closed != nil {
+		*closed = ptr(*b.closed)
+	}
+	if len(b.errs) > 0 {
+		return fmt.Errorf("%w: %w", ErrInvalidQuery, errors.Join(b.errs...))
+	}
+	return nil
+}
+
+func checkPage(b *queryBuilder, limit, offset int) {
+	if limit < 0 {
+		b.fail("negative limit %d", limit)
+	}
+	if offset < 0 {
+		b.fail("negative offset %d", offset)
+	}
+}
+
+func checkFloatRange(b *queryBuilder, name string, lo, hi *float64) {
+	if lo != nil && *lo < 0 {
+		b.fail("negative minimum %s %v", name, *lo)
+	}
+	if lo != nil && hi != nil && *lo > *hi {
+		b.fail("inverted %s range: min %v > max %v", name, *lo, *hi)
+	}
+}
+
+func checkTimeRange(b *queryBuilder, name string, lo, hi *NormalizedTime) {
+	if lo != nil && hi != nil && lo.Time().After(hi.Time()) {
+		b.fail("inverted %s range: %s is after %s", name, lo.Time().Format(time.RFC3339), hi.Time().Format(time.RFC3339))
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func timePtr(t time.Time) *NormalizedTime {
+	return ptr(NormalizedTime(t))
+}
+
+// MarketsQuery builds validated GetMarketsParams with a fluent API:
+//
+//	params, err := NewMarketsQuery().Open().MinLiquidity(1000).OrderBy(OrderVolume24hr, Desc).Limit(100).Build()
+type MarketsQuery struct {
+	queryBuilder
+	params GetMarketsParams
+}
+
+// NewMarketsQuery starts an empty markets query
+func NewMarketsQuery() *MarketsQuery {
+	return &MarketsQuery{}
+}
+
+// Limit sets the page size
+func (q *MarketsQuery) Limit(n int) *MarketsQuery {
+	q.params.Limit = n
+	return q
+}
+
+// Offset sets the pagination offset
+func (q *MarketsQuery) Offset(n int) *MarketsQuery {
+	q.params.Offset = n
+	return q
+}
+
+// OrderBy adds an order field. All fields share one direction.
+func (q *MarketsQuery) OrderBy(field OrderField, dir SortDirection) *MarketsQuery {
+	q.orderBy(field, dir)
+	return q
+}
+
+// Open restricts results to markets that are not closed
+func (q *MarketsQuery) Open() *MarketsQuery {
+	q.setClosed(false)
+	return q
+}
+
+// Closed restricts results to closed markets
+func (q *MarketsQuery) Closed() *MarketsQuery {
+	q.setClosed(true)
+	return q
+}
+
+// IDs filters by market IDs
+func (q *MarketsQuery) IDs(ids ...int) *MarketsQuery {
+	q.params.ID = append(q.params.ID, ids...)
+	return q
+}
+
+// Slugs filters by market slugs
+func (q *MarketsQuery) Slugs(slugs ...string) *MarketsQuery {
+	q.params.Slug = append(q.params.Slug, slugs...)
+	return q
+}
+
+// ClobTokenIDs filters by CLOB token IDs
+func (q *MarketsQuery) ClobTokenIDs(ids ...string) *MarketsQuery {
+	q.params.ClobTokenIDs = append(q.params.ClobTokenIDs, ids...)
+	return q
+}
+
+// ConditionIDs filters by condition IDs
+func (q *MarketsQuery) ConditionIDs(ids ...string) *MarketsQuery {
+	q.params.ConditionIDs = append(q.params.ConditionIDs, ids...)
+	return q
+}
+
+// QuestionIDs filters by question IDs
+func (q *MarketsQuery) QuestionIDs(ids ...string) *MarketsQuery {
+	q.params.QuestionIDs = append(q.params.QuestionIDs, ids...)
+	return q
+}
+
+// MarketMakers filters by market maker addresses
+func (q *MarketsQuery) MarketMakers(addresses ...string) *MarketsQuery {
+	q.params.MarketMakerAddress = append(q.params.MarketMakerAddress, addresses...)
+	return q
+}
+
+// MinLiquidity sets the minimum liquidity
+func (q *MarketsQuery) MinLiquidity(v float64) *MarketsQuery {
+	q.params.LiquidityNumMin = ptr(v)
+	return q
+}
+
+// MaxLiquidity sets the maximum liquidity
+func (q *MarketsQuery) MaxLiquidity(v float64) *MarketsQuery {
+	q.params.LiquidityNumMax = ptr(v)
+	return q
+}
+
+// MinVolume sets the minimum total volume
+func (q *MarketsQuery) MinVolume(v float64) *MarketsQuery {
+	q.params.VolumeNumMin = ptr(v)
+	return q
+}
+
+// MaxVolume sets the maximum total volume
+func (q *MarketsQuery) MaxVolume(v float64) *MarketsQuery {
+	q.params.VolumeNumMax = ptr(v)
+	return q
+}
+
+// StartingBetween restricts the market start date to [from, to]
+func (q *MarketsQuery) StartingBetween(from, to time.Time) *MarketsQuery {
+	q.params.StartDateMin = timePtr(from)
+	q.params.StartDateMax = timePtr(to)
+	return q
+}
+
+// StartingAfter sets the minimum market start date
+func (q *MarketsQuery) StartingAfter(t time.Time) *MarketsQuery {
+	q.params.StartDateMin = timePtr(t)
+	return q
+}
+
+// StartingBefore sets the maximum market start date
+func (q *MarketsQuery) StartingBefore(t time.Time) *MarketsQuery {
+	q.params.StartDateMax = timePtr(t)
+	return q
+}
+
+// EndingBetween restricts the market end date to [from, to]
+func (q *MarketsQuery) EndingBetween(from, to time.Time) *MarketsQuery {
+	q.params.EndDateMin = timePtr(from)
+	q.params.EndDateMax = timePtr(to)
+	return q
+}
+
+// EndingAfter sets the minimum market end date
+func (q *MarketsQuery) EndingAfter(t time.Time) *MarketsQuery {
+	q.params.EndDateMin = timePtr(t)
+	return q
+}
+
+// EndingBefore sets the maximum market end date
+func (q *MarketsQuery) EndingBefore(t time.Time) *MarketsQuery {
+	q.params.EndDateMax = timePtr(t)
+	return q
+}
+
+// Tag filters by tag ID
+func (q *MarketsQuery) Tag(id int) *MarketsQuery {
+	q.params.TagID = ptr(id)
+	return q
+}
+
+// RelatedTags also matches tags related to the Tag filter
+func (q *MarketsQuery) RelatedTags() *MarketsQuery {
+	q.params.RelatedTags = ptr(true)
+	return q
+}
+
+// CYOM filters "create your own market" markets
+func (q *MarketsQuery) CYOM(cyom bool) *MarketsQuery {
+	q.params.CYOM = ptr(cyom)
+	return q
+}
+
+// UMAResolutionStatus filters by UMA resolution status
+func (q *MarketsQuery) UMAResolutionStatus(status UMAResolutionStatus) *MarketsQuery {
+	q.params.UMAResolutionStatus = status
+	return q
+}
+
+// GameID filters by sports game ID
+func (q *MarketsQuery) GameID(id string) *MarketsQuery {
+	q.params.GameID = id
+	return q
+}
+
+// SportsMarketTypes filters by sports market types
+func (q *MarketsQuery) SportsMarketTypes(types ...SportsMarketType) *MarketsQuery {
+	q.params.SportsMarketTypes = append(q.params.SportsMarketTypes, types...)
+	return q
+}
+
+// MinRewardsSize sets the minimum rewards size
+func (q *MarketsQuery) MinRewardsSize(v float64) *MarketsQuery {
+	q.params.RewardsMinSize = ptr(v)
+	return q
+}
+
+// IncludeTag includes tag details in the response
+func (q *MarketsQuery) IncludeTag() *MarketsQuery {
+	q.params.IncludeTag = ptr(true)
+	return q
+}
+
+// Build validates the query and returns the params. The error wraps ErrInvalidQuery and lists every problem found.
+func (q *MarketsQuery) Build() (*GetMarketsParams, error) {
+	p := q.params
+	// Copy the slices so later builder calls can't append into the returned params
+	p.ID = slices.Clone(p.ID)
+	p.Slug = slices.Clone(p.Slug)
+	p.ClobTokenIDs = slices.Clone(p.ClobTokenIDs)
+	p.ConditionIDs = slices.Clone(p.ConditionIDs)
+	p.QuestionIDs = slices.Clone(p.QuestionIDs)
+	p.MarketMakerAddress = slices.Clone(p.MarketMakerAddress)
+	p.SportsMarketTypes = slices.Clone(p.SportsMarketTypes)
+	b := q.queryBuilder
+	b.errs = append([]error(nil), q.errs...)
+
+	checkPage(&b, p.Limit, p.Offset)
+	checkFloatRange(&b, "liquidity", p.LiquidityNumMin, p.LiquidityNumMax)
+	checkFloatRange(&b, "volume", p.VolumeNumMin, p.VolumeNumMax)
+	checkTimeRange(&b, "start date", p.StartDateMin, p.StartDateMax)
+	checkTimeRange(&b, "end date", p.EndDateMin, p.EndDateMax)
+	if p.RewardsMinSize != nil && *p.RewardsMinSize < 0 {
+		b.fail("negative rewards size %v", *p.RewardsMinSize)
+	}
+	if p.RelatedTags != nil && p.TagID == nil {
+		b.fail("RelatedTags requires Tag")
+	}
+
+	if err := p.validate(); err != nil {
+		b.errs = append(b.errs, err)
+	}
+
+	if err := b.result(&p.Order, &p.Ascending, &p.Closed); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// EventsQuery builds validated GetEventsParams with a fluent API:
+//
+//	params, err := NewEventsQuery().Open().Tag(2).ExcludeTags(100).OrderBy(OrderVolume, Desc).Build()
+type EventsQuery struct {
+	queryBuilder
+	params GetEventsParams
+}
+
+// NewEventsQuery starts an empty events query
+func NewEventsQuery() *EventsQuery {
+	return &EventsQuery{}
+}
+
+// Limit sets the page size
+func (q *EventsQuery) Limit(n int) *EventsQuery {
+	q.params.Limit = n
+	return q
+}
+
+// Offset sets the pagination offset
+func (q *EventsQuery) Offset(n int) *EventsQuery {
+	q.params.Offset = n
+	return q
+}
+
+// OrderBy adds an order field. All fields share one direction.
+func (q *EventsQuery) OrderBy(field OrderField, dir SortDirection) *EventsQuery {
+	q.orderBy(field, dir)
+	return q
+}
+
+// Open restricts results to events that are not closed
+func (q *EventsQuery) Open() *EventsQuery {
+	q.setClosed(false)
+	return q
+}
+
+// Closed restricts results to closed events
+func (q *EventsQuery) Closed() *EventsQuery {
+	q.setClosed(true)
+	return q
+}
+
+// IDs filters by event IDs
+func (q *EventsQuery) IDs(ids ...int) *EventsQuery {
+	q.params.ID = append(q.params.ID, ids...)
+	return q
+}
+
+// Slugs filters by event slugs
+func (q *EventsQuery) Slugs(slugs ...string) *EventsQuery {
+	q.params.Slug = append(q.params.Slug, slugs...)
+	return q
+}
+
+// Tag filters by tag ID
+func (q *EventsQuery) Tag(id int) *EventsQuery {
+	q.params.TagID = ptr(id)
+	return q
+}
+
+// ExcludeTags removes events carrying any of the tag IDs
+func (q *EventsQuery) ExcludeTags(ids ...int) *EventsQuery {
+	q.params.ExcludeTagID = append(q.params.ExcludeTagID, ids...)
+	return q
+}
+
+// RelatedTags also matches tags related to the Tag filter
+func (q *EventsQuery) RelatedTags() *EventsQuery {
+	q.params.RelatedTags = ptr(true)
+	return q
+}
+
+// Featured filters by featured status
+func (q *EventsQuery) Featured(featured bool) *EventsQuery {
+	q.params.Featured = ptr(featured)
+	return q
+}
+
+// CYOM filters "create your own market" events
+func (q *EventsQuery) CYOM(cyom bool) *EventsQuery {
+	q.params.CYOM = ptr(cyom)
+	return q
+}
+
+// IncludeChat includes chat channels in the response
+func (q *EventsQuery) IncludeChat() *EventsQuery {
+	q.params.IncludeChat = ptr(true)
+	return q
+}
+
+// IncludeTemplate includes templates in the response
+func (q *EventsQuery) IncludeTemplate() *EventsQuery {
+	q.params.IncludeTemplate = ptr(true)
+	return q
+}
+
+// Recurrence filters by recurrence
+func (q *EventsQuery) Recurrence(recurrence Recurrence) *EventsQuery {
+	q.params.Recurrence = recurrence
+	return q
+}
+
+// StartingBetween restricts the event start date to [from, to]
+func (q *EventsQuery) StartingBetween(from, to time.Time) *EventsQuery {
+	q.params.StartDateMin = timePtr(from)
+	q.params.StartDateMax = timePtr(to)
+	return q
+}
+
+// StartingAfter sets the minimum event start date
+func (q *EventsQuery) StartingAfter(t time.Time) *EventsQuery {
+	q.params.StartDateMin = timePtr(t)
+	return q
+}
+
+// StartingBefore sets the maximum event start date
+func (q *EventsQuery) StartingBefore(t time.Time) *EventsQuery {
+	q.params.StartDateMax = timePtr(t)
+	return q
+}
+
+// EndingBetween restricts the event end date to [from, to]
+func (q *EventsQuery) EndingBetween(from, to time.Time) *EventsQuery {
+	q.params.EndDateMin = timePtr(from)
+	q.params.EndDateMax = timePtr(to)
+	return q
+}
+
+// EndingAfter sets the minimum event end date
+func (q *EventsQuery) EndingAfter(t time.Time) *EventsQuery {
+	q.params.EndDateMin = timePtr(t)
+	return q
+}
+
+// EndingBefore sets the maximum event end date
+func (q *EventsQuery) EndingBefore(t time.Time) *EventsQuery {
+	q.params.EndDateMax = timePtr(t)
+	return q
+}
+
+// Build validates the query and returns the params. The error wraps ErrInvalidQuery and lists every problem found.
+func (q *EventsQuery) Build() (*GetEventsParams, error) {
+	p := q.params
+	// Copy the slices so later builder calls can't append into the returned params
+	p.ID = slices.Clone(p.ID)
+	p.Slug = slices.Clone(p.Slug)
+	p.ExcludeTagID = slices.Clone(p.ExcludeTagID)
+	b := q.queryBuilder
+	b.errs = append([]error(nil), q.errs...)
+
+	checkPage(&b, p.Limit, p.Offset)
+	checkTimeRange(&b, "start date", p.StartDateMin, p.StartDateMax)
+	checkTimeRange(&b, "end date", p.EndDateMin, p.EndDateMax)
+	if p.RelatedTags != nil && p.TagID == nil {
+		b.fail("RelatedTags requires Tag")
+	}
+	if p.TagID != nil {
+		for _, id := range p.ExcludeTagID {
+			if id == *p.TagID {
+				b.fail("tag %d is both included and excluded", id)
+			}
+		}
+	}
+
+	if err := p.validate(); err != nil {
+		b.errs = append(b.errs, err)
+	}
+
+	if err := b.result(&p.Order, &p.Ascending, &p.Closed); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
@@ -0,0 +1,181 @@
+package polymarketgamma
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMarketsQueryBuild(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	params, err := NewMarketsQuery().
+		Open().
+		MinLiquidity(1000).
+		EndingBetween(from, to).
+		OrderBy(OrderVolume24hr, Desc).
+		OrderBy(OrderLiquidity, Desc).
+		Tag(2).
+		RelatedTags().
+		Limit(100).
+		Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+
+	if params.Closed == nil || *params.Closed {
+		t.Errorf("Closed = %v, want false", params.Closed)
+	}
+	if params.LiquidityNumMin == nil || *params.LiquidityNumMin != 1000 {
+		t.Errorf("LiquidityNumMin = %v, want 1000", params.LiquidityNumMin)
+	}
+	if !params.EndDateMin.Time().Equal(from) || !params.EndDateMax.Time().Equal(to) {
+		t.Errorf("end date range = %v..%v", params.EndDateMin, params.EndDateMax)
+	}
+	if params.Order != "volume24hr,liquidity" || params.Ascending == nil || *params.Ascending {
+		t.Errorf("order = %q ascending %v", params.Order, params.Ascending)
+	}
+	if params.Limit != 100 || params.TagID == nil || *params.TagID != 2 {
+		t.Errorf("params = %+v", params)
+	}
+}
+
+func TestQueryBuildErrors(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name  string
+		build func() error
+		want  []string
+	}{
+		{
+			name: "inverted liquidity range",
+			build: func() error {
+				_, err := NewMarketsQuery().MinLiquidity(500).MaxLiquidity(100).Build()
+				return err
+			},
+			want: []string{"inverted liquidity range"},
+		},
+		{
+			name: "negative limit and offset",
+			build: func() error {
+				_, err := NewMarketsQuery().Limit(-1).Offset(-5).Build()
+				return err
+			},
+			want: []string{"negative limit", "negative offset"},
+		},
+		{
+			name: "open and closed",
+			build: func() error {
+				_, err := NewMarketsQuery().Open().Closed().Build()
+				return err
+			},
+			want: []string{"mutually exclusive"},
+		},
+		{
+			name: "conflicting directions",
+			build: func() error {
+				_, err := NewEventsQuery().OrderBy(OrderVolume, Desc).OrderBy(OrderStartDate, Asc).Build()
+				return err
+			},
+			want: []string{"conflicting sort directions"},
+		},
+		{
+			name: "unknown enum values",
+			build: func() error {
+				_, err := NewMarketsQuery().OrderBy("volume24h", Desc).UMAResolutionStatus("pending").Build()
+				return err
+			},
+			want: []string{`unknown order field "volume24h"`, `unknown UMA resolution status "pending"`},
+		},
+		{
+			name: "inverted end dates",
+			build: func() error {
+				_, err := NewEventsQuery().EndingBetween(now, now.Add(-time.Hour)).Build()
+				return err
+			},
+			want: []string{"inverted end date range"},
+		},
+		{
+			name: "related tags without tag",
+			build: func() error {
+				_, err := NewEventsQuery().RelatedTags().Build()
+				return err
+			},
+			want: []string{"RelatedTags requires Tag"},
+		},
+		{
+			name: "tag included and excluded",
+			build: func() error {
+				_, err := NewEventsQuery().Tag(7).ExcludeTags(3, 7).Build()
+				return err
+			},
+			want: []string{"tag 7 is both included and excluded"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.build()
+			if !errors.Is(err, ErrInvalidQuery) {
+				t.Fatalf("error = %v, want ErrInvalidQuery", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error %q does not mention %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestQueryBuildIsRepeatable(t *testing.T) {
+	q := NewEventsQuery().Closed().OrderBy(OrderEndDate, Asc).Limit(10)
+
+	first, err := q.Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	second, err := q.Limit(20).Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+
+	if first.Limit != 10 || second.Limit != 20 {
+		t.Errorf("limits = %d, %d, want 10, 20", first.Limit, second.Limit)
+	}
+	if first.Order != "endDate" || second.Order != "endDate" {
+		t.Errorf("orders = %q, %q", first.Order, second.Order)
+	}
+}
+
+func TestQueryBuildCopiesSlices(t *testing.T) {
+	markets := NewMarketsQuery().IDs(1, 2).ConditionIDs("0xa")
+	built, err := markets.Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	built.ID[0] = 99
+	built.ConditionIDs = append(built.ConditionIDs[:0], "0xz")
+
+	again, err := markets.IDs(3).Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	if !slices.Equal(again.ID, []int{1, 2, 3}) || !slices.Equal(again.ConditionIDs, []string{"0xa"}) {
+		t.Errorf("builder changed through built params: ID = %v, ConditionIDs = %v", again.ID, again.ConditionIDs)
+	}
+
+	events := NewEventsQuery().Slugs("a", "b")
+	first, err := events.Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	first.Slug[1] = "z"
+	if second, _ := events.Build(); !slices.Equal(second.Slug, []string{"a", "b"}) {
+		t.Errorf("Slug = %v, want [a b]", second.Slug)
+	}
+}
@@ -0,0 +1,151 @@
+package polymarketgamma
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token-bucket rate limiter. It is safe for concurrent use
+// and may be shared between several clients.
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64 // tokens added per second
+	burst  float64 // bucket capacity
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter creates a token-bucket limiter allowing rps requests per second with the given burst.
+// The bucket starts full. A burst below 1 is treated as 1.
+func NewRateLimiter(rps float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		rate:   rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a token is available or ctx is done, and returns how long it waited
+func (l *RateLimiter) Wait(ctx context.Context) (time.Duration, error) {
+	if l == nil || l.rate <= 0 {
+		return 0, nil
+	}
+
+	wait := l.reserve()
+	if wait <= 0 {
+		return 0, nil
+	}
+
+	if err := sleepContext(ctx, wait); err != nil {
+		l.cancel()
+		return wait, err
+	}
+
+	return wait, nil
+}
+
+// reserve takes a token, possibly going into debt, and returns the time until that token is available
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel returns a reserved token that was never used
+func (l *RateLimiter) cancel() {
+	if l == nil || l.rate <= 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens = min(l.burst, l.tokens+1)
+}
+
+// WithRateLimit limits the client to rps requests per second with the given burst across all endpoints
+func WithRateLimit(rps float64, burst int) Option {
+	return func(c *Client) {
+		c.rateLimiter = NewRateLimiter(rps, burst)
+	}
+}
+
+// WithRateLimiter uses an existing limiter for all endpoints, allowing it to be shared between clients
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(c *Client) {
+		c.rateLimiter = limiter
+	}
+}
+
+// WithEndpointRateLimit adds a limit for a single endpoint family such as "/markets", "/events" or "/public-search".
+// It applies in addition to any client-wide limit.
+func WithEndpointRateLimit(endpoint string, rps float64, burst int) Option {
+	return func(c *Client) {
+		if c.endpointLimiters == nil {
+			c.endpointLimiters = make(map[string]*RateLimiter)
+		}
+		c.endpointLimiters["/"+strings.Trim(endpoint, "/")] = NewRateLimiter(rps, burst)
+	}
+}
+
+// WithRateLimitHook registers a callback invoked with the time each request waited for a rate limit token
+func WithRateLimitHook(hook func(endpoint string, wait time.Duration)) Option {
+	return func(c *Client) {
+		c.rateLimitHook = hook
+	}
+}
+
+// waitRateLimit waits for the endpoint-specific and client-wide limiters before a request is sent.
+// If a wait fails, tokens already taken from the other limiter are returned.
+// The wait happens before the transport, so responses served by a DiskCache still spend tokens;
+// only in-memory cache hits (WithCache) are free.
+func (c *Client) waitRateLimit(ctx context.Context, path string) error {
+	if c.rateLimiter == nil && len(c.endpointLimiters) == 0 {
+		return nil
+	}
+
+	endpoint := endpointFamily(path)
+
+	var total time.Duration
+	var acquired []*RateLimiter
+	for _, limiter := range []*RateLimiter{c.endpointLimiters[endpoint], c.rateLimiter} {
+		wait, err := limiter.Wait(ctx)
+		total += wait
+		if err != nil {
+			for _, l := range acquired {
+				l.cancel()
+			}
+			return err
+		}
+		acquired = append(acquired, limiter)
+	}
+
+	if c.rateLimitHook != nil {
+		c.rateLimitHook(endpoint, total)
+	}
+
+	return nil
+}
+
+// endpointFamily returns the first path segment of a request path, e.g. "/markets" for "/markets/slug/foo?x=1"
+func endpointFamily(path string) string {
+	path, _, _ = strings.Cut(path, "?")
+	path = strings.TrimPrefix(path, "/")
+	segment, _, _ := strings.Cut(path, "/")
+	return "/" + segment
+}
@@ -0,0 +1,115 @@
+package polymarketgamma
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterWait(t *testing.T) {
+	limiter := NewRateLimiter(100, 2)
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 6; i++ {
+		if _, err := limiter.Wait(ctx); err != nil {
+			t.Fatalf("Wait failed: %v", err)
+		}
+	}
+	// 2 tokens are available immediately, the remaining 4 arrive every 10ms
+	if elapsed := time.Since(start); elapsed < 35*time.Millisecond {
+		t.Errorf("6 waits took %v, expected at least ~40ms", elapsed)
+	}
+}
+
+func TestRateLimiterContextCancel(t *testing.T) {
+	limiter := NewRateLimiter(1, 1)
+	if _, err := limiter.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait failed: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := limiter.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestWaitRateLimitReturnsTokensOnCancel(t *testing.T) {
+	client := NewClient(nil, WithRateLimit(0.001, 1), WithEndpointRateLimit("/markets", 0.001, 1))
+	// Drain the client-wide bucket so the next request has to wait for it
+	client.rateLimiter.reserve()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := client.waitRateLimit(ctx, "/markets"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+
+	endpoint := client.endpointLimiters["/markets"]
+	endpoint.mu.Lock()
+	defer endpoint.mu.Unlock()
+	if endpoint.tokens < 1 {
+		t.Errorf("endpoint tokens = %v, want the token returned after the failed wait", endpoint.tokens)
+	}
+}
+
+func TestEndpointFamily(t *testing.T) {
+	tests := map[string]string{
+		"/":                         "/",
+		"/markets?limit=1":          "/markets",
+		"/markets/slug/foo":         "/markets",
+		"/public-search?q=election": "/public-search",
+		"/tags/1/related-tags":      "/tags",
+	}
+	for path, want := range tests {
+		if got := endpointFamily(path); got != want {
+			t.Errorf("endpointFamily(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
+
+func TestClientRateLimitHook(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	var mu sync.Mutex
+	waits := make(map[string][]time.Duration)
+	client := NewClient(nil,
+		WithBaseURL(server.URL),
+		WithEndpointRateLimit("markets", 50, 1),
+		WithRateLimitHook(func(endpoint string, wait time.Duration) {
+			mu.Lock()
+			defer mu.Unlock()
+			waits[endpoint] = append(waits[endpoint], wait)
+		}),
+	)
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		if _, err := client.GetMarkets(ctx, nil); err != nil {
+			t.Fatalf("GetMarkets failed: %v", err)
+		}
+		if _, err := client.GetTags(ctx, nil); err != nil {
+			t.Fatalf("GetTags failed: %v", err)
+		}
+	}
+
+	if len(waits["/markets"]) != 3 || len(waits["/tags"]) != 3 {
+		t.Fatalf("unexpected hook calls: %v", waits)
+	}
+	if waits["/markets"][2] == 0 {
+		t.Error("expected /markets requests to wait for tokens")
+	}
+	for _, wait := range waits["/tags"] {
+		if wait != 0 {
+			t.Errorf("/tags is not limited but waited %v", wait)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package polymarketgamma
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried
+type RetryPolicy struct {
+	MaxAttempts          int           // Total attempts including the first one; values <= 1 disable retries
+	InitialBackoff       time.Duration // Delay before the first retry
+	MaxBackoff           time.Duration // Upper bound for the exponential backoff delay
+	Multiplier           float64       // Backoff growth factor between attempts (defaults to 2)
+	Jitter               float64       // Random fraction (0-1) applied to each delay to spread out retries
+	RetryableStatusCodes []int         // HTTP status codes that trigger a retry
+	RetryNetworkErrors   bool          // Retry transport errors such as connection resets and timeouts
+	RespectRetryAfter    bool          // Wait for the Retry-After header duration when present
+	MaxRetryAfter        time.Duration // Give up instead of waiting when Retry-After exceeds this (0 = no limit)
+
+	// ShouldRetry, when set, overrides the status code and network error checks
+	ShouldRetry func(err error) bool
+}
+
+// DefaultRetryPolicy returns a policy suitable for long-running scanners:
+// 4 attempts, exponential backoff from 250ms to 10s with 20% jitter,
+// retrying 429, 500, 502, 503 and 504 responses and network errors, honoring Retry-After
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    4,
+		InitialBackoff: 250 * time.Millisecond,
+		MaxBackoff:     10 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryNetworkErrors: true,
+		RespectRetryAfter:  true,
+		MaxRetryAfter:      time.Minute,
+	}
+}
+
+// WithRetryPolicy enables automatic retries for every request
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// shouldRetry reports whether err is retryable under the policy.
+// Errors caused by the caller's context, offline cache misses and oversized responses are never retried.
+func (p RetryPolicy) shouldRetry(ctx context.Context, err error) bool {
+	if ctx.Err() != nil || errors.Is(err, ErrCacheMiss) || errors.Is(err, ErrResponseTooLarge) {
+		return false
+	}
+
+	if p.ShouldRetry != nil {
+		return p.ShouldRetry(err)
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		for _, code := range p.RetryableStatusCodes {
+			if apiErr.StatusCode == code {
+				return true
+			}
+		}
+		return false
+	}
+
+	return p.RetryNetworkErrors
+}
+
+// delay returns how long to wait before the next attempt, and false if the
+// server asked for a longer wait than the policy allows
+func (p RetryPolicy) delay(attempt int, err error) (time.Duration, bool) {
+	if p.RespectRetryAfter {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) {
+			if wait, ok := parseRetryAfter(apiErr.Header.Get("Retry-After")); ok {
+				if p.MaxRetryAfter > 0 && wait > p.MaxRetryAfter {
+					return 0, false
+				}
+				return wait, true
+			}
+		}
+	}
+
+	multiplier := p.Multiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+
+	backoff := float64(p.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && backoff > float64(p.MaxBackoff) {
+		backoff = float64(p.MaxBackoff)
+	}
+
+	if p.Jitter > 0 {
+		jitter := math.Min(p.Jitter, 1)
+		backoff += backoff * jitter * (2*rand.Float64() - 1)
+	}
+
+	return time.Duration(backoff), true
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		wait := time.Until(t)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// sleepContext waits for d or until ctx is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
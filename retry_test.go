@@ -0,0 +1,161 @@
+package polymarketgamma
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func fastRetryPolicy() RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.InitialBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+	policy.Jitter = 0
+	return policy
+}
+
+func TestRetryOnTransientErrors(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{"data":"OK"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL), WithRetryPolicy(fastRetryPolicy()))
+	if _, err := client.HealthCheck(context.Background()); err != nil {
+		t.Fatalf("HealthCheck failed: %v", err)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("calls = %d, want 3", calls.Load())
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL), WithRetryPolicy(fastRetryPolicy()))
+	_, err := client.HealthCheck(context.Background())
+	if !IsServerError(err) {
+		t.Fatalf("expected server error, got %v", err)
+	}
+	if calls.Load() != 4 {
+		t.Errorf("calls = %d, want 4", calls.Load())
+	}
+}
+
+func TestRetrySkipsNonRetryableStatus(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL), WithRetryPolicy(fastRetryPolicy()))
+	_, err := client.GetMarketByID(context.Background(), "1", nil)
+	if !IsNotFound(err) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("calls = %d, want 1", calls.Load())
+	}
+}
+
+func TestRetryHonorsRetryAfter(t *testing.T) {
+	var calls atomic.Int32
+	var first, second time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			first = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		second = time.Now()
+		w.Write([]byte(`{"data":"OK"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL), WithRetryPolicy(fastRetryPolicy()))
+	if _, err := client.HealthCheck(context.Background()); err != nil {
+		t.Fatalf("HealthCheck failed: %v", err)
+	}
+	if waited := second.Sub(first); waited < 900*time.Millisecond {
+		t.Errorf("waited %v, want at least ~1s from Retry-After", waited)
+	}
+}
+
+func TestRetryRespectsContextCancellation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	client := NewClient(nil, WithBaseURL(server.URL), WithRetryPolicy(fastRetryPolicy()))
+	start := time.Now()
+	_, err := client.HealthCheck(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context deadline error, got %v", err)
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Error("retry did not stop on context cancellation")
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{value: "", ok: false},
+		{value: "5", want: 5 * time.Second, ok: true},
+		{value: "-1", ok: false},
+		{value: "soon", ok: false},
+		{value: time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat), want: 0, ok: true},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseRetryAfter(tt.value)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("parseRetryAfter(%q) = %v, %v; want %v, %v", tt.value, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second, Multiplier: 2}
+
+	wants := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second}
+	for i, want := range wants {
+		got, ok := policy.delay(i+1, errors.New("boom"))
+		if !ok || got != want {
+			t.Errorf("delay(%d) = %v, want %v", i+1, got, want)
+		}
+	}
+
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		got, _ := policy.delay(1, errors.New("boom"))
+		if got < 50*time.Millisecond || got > 150*time.Millisecond {
+			t.Fatalf("jittered delay %v out of range", got)
+		}
+	}
+}
@@ -2,9 +2,7 @@ package polymarketgamma
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"net/url"
 )
 
 // Search searches for markets, events, and profiles
@@ -13,64 +11,92 @@ func (c *Client) Search(ctx context.Context, params *SearchParams) (*SearchRespo
 		return nil, fmt.Errorf("search query (q) is required")
 	}
 
-	path := "/public-search?"
-	urlParams := url.Values{}
+	if err := c.checkParams(params); err != nil {
+		return nil, err
+	}
 
-	// Required parameter
-	urlParams.Add("q", params.Q)
+	path := "/public-search?" + params.Values().Encode()
 
-	// Optional parameters
-	if params.Cache != nil {
-		urlParams.Add("cache", fmt.Sprintf("%t", *params.Cache))
+	response, err := fetch[SearchResponse](ctx, c, "Search", params, path)
+	if err != nil {
+		return nil, err
 	}
-	if params.EventsStatus != "" {
-		urlParams.Add("events_status", params.EventsStatus)
+
+	return &response, nil
+}
+
+// DefaultSearchMaxPages is the page budget used by SearchAll when maxPages is not positive
+const DefaultSearchMaxPages = 10
+
+// SearchResults holds the merged results of several search pages
+type SearchResults struct {
+	Events       []Event
+	Tags         []SearchTag
+	Profiles     []Profile
+	Pages        int  // Number of pages fetched
+	TotalResults int  // Total results reported by the last page
+	HasMore      bool // Pagination.HasMore of the last page: true if results remain, e.g. when the page budget ran out
+}
+
+// SearchAll walks search pages starting at params.Page (or 1) until Pagination.HasMore is false
+// or maxPages pages have been fetched. Events, tags and profiles are de-duplicated by ID; pages that
+// bring only duplicates do not end the walk, so the page budget is what bounds a repeating API.
+// On error the results collected so far are returned together with the error.
+func (c *Client) SearchAll(ctx context.Context, params *SearchParams, maxPages int) (*SearchResults, error) {
+	if params == nil || params.Q == "" {
+		return nil, fmt.Errorf("search query (q) is required")
 	}
-	if params.LimitPerType != nil {
-		urlParams.Add("limit_per_type", fmt.Sprintf("%d", *params.LimitPerType))
+	if maxPages <= 0 {
+		maxPages = DefaultSearchMaxPages
 	}
+
+	pageParams := *params
+	page := 1
 	if params.Page != nil {
-		urlParams.Add("page", fmt.Sprintf("%d", *params.Page))
-	}
-	for _, tag := range params.EventsTag {
-		urlParams.Add("events_tag", tag)
-	}
-	if params.KeepClosedMarkets != nil {
-		urlParams.Add("keep_closed_markets", fmt.Sprintf("%d", *params.KeepClosedMarkets))
-	}
-	if params.Sort != "" {
-		urlParams.Add("sort", params.Sort)
-	}
-	if params.Ascending != nil {
-		urlParams.Add("ascending", fmt.Sprintf("%t", *params.Ascending))
-	}
-	if params.SearchTags != nil {
-		urlParams.Add("search_tags", fmt.Sprintf("%t", *params.SearchTags))
-	}
-	if params.SearchProfiles != nil {
-		urlParams.Add("search_profiles", fmt.Sprintf("%t", *params.SearchProfiles))
-	}
-	if params.Recurrence != "" {
-		urlParams.Add("recurrence", params.Recurrence)
-	}
-	for _, tagID := range params.ExcludeTagID {
-		urlParams.Add("exclude_tag_id", fmt.Sprintf("%d", tagID))
-	}
-	if params.Optimized != nil {
-		urlParams.Add("optimized", fmt.Sprintf("%t", *params.Optimized))
+		page = *params.Page
 	}
 
-	path += urlParams.Encode()
+	results := &SearchResults{}
+	seenEvents := make(map[string]struct{})
+	seenTags := make(map[string]struct{})
+	seenProfiles := make(map[string]struct{})
 
-	respBody, err := c.doRequest(ctx, "GET", path)
-	if err != nil {
-		return nil, err
-	}
+	for results.Pages < maxPages {
+		current := page
+		pageParams.Page = &current
 
-	var response SearchResponse
-	if err := json.Unmarshal(respBody, &response); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+		resp, err := c.Search(ctx, &pageParams)
+		if err != nil {
+			return results, fmt.Errorf("search page %d: %w", page, err)
+		}
+		results.Pages++
+		results.TotalResults = resp.Pagination.TotalResults
+
+		for _, event := range resp.Events {
+			if _, ok := seenEvents[event.ID]; !ok {
+				seenEvents[event.ID] = struct{}{}
+				results.Events = append(results.Events, event)
+			}
+		}
+		for _, tag := range resp.Tags {
+			if _, ok := seenTags[tag.ID]; !ok {
+				seenTags[tag.ID] = struct{}{}
+				results.Tags = append(results.Tags, tag)
+			}
+		}
+		for _, profile := range resp.Profiles {
+			if _, ok := seenProfiles[profile.ID]; !ok {
+				seenProfiles[profile.ID] = struct{}{}
+				results.Profiles = append(results.Profiles, profile)
+			}
+		}
+
+		results.HasMore = resp.Pagination.HasMore
+		if !results.HasMore {
+			return results, nil
+		}
+		page++
 	}
 
-	return &response, nil
+	return results, nil
 }
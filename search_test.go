@@ -2,12 +2,14 @@ package polymarketgamma
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
 func TestSearch(t *testing.T) {
-	client := NewClient(http.DefaultClient)
+	client := newRecordedClient(t)
 	ctx := context.Background()
 
 	// Test basic search
@@ -246,7 +248,7 @@ func TestSearch(t *testing.T) {
 }
 
 func TestSearchDifferentQueries(t *testing.T) {
-	client := NewClient(http.DefaultClient)
+	client := newRecordedClient(t)
 	ctx := context.Background()
 
 	queries := []string{
@@ -281,3 +283,88 @@ func TestSearchDifferentQueries(t *testing.T) {
 		})
 	}
 }
+
+func TestSearchAll(t *testing.T) {
+	pages := map[string]string{
+		"1": `{"events":[{"id":"e1"},{"id":"e2"}],"tags":[{"id":"t1"}],"profiles":[{"id":"p1"}],"pagination":{"hasMore":true,"totalResults":5}}`,
+		"2": `{"events":[{"id":"e2"},{"id":"e3"}],"tags":[{"id":"t1"}],"profiles":[],"pagination":{"hasMore":true,"totalResults":5}}`,
+		"3": `{"events":[],"tags":[],"profiles":[{"id":"p2"}],"pagination":{"hasMore":false,"totalResults":5}}`,
+	}
+	// The middle page only repeats results from the first one
+	duplicatePages := map[string]string{
+		"1": `{"events":[{"id":"e1"}],"tags":[],"profiles":[],"pagination":{"hasMore":true,"totalResults":2}}`,
+		"2": `{"events":[{"id":"e1"}],"tags":[],"profiles":[],"pagination":{"hasMore":true,"totalResults":2}}`,
+		"3": `{"events":[{"id":"e2"}],"tags":[],"profiles":[],"pagination":{"hasMore":false,"totalResults":2}}`,
+	}
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		requested = append(requested, page)
+		if r.URL.Query().Get("q") == "duplicates" {
+			w.Write([]byte(duplicatePages[page]))
+			return
+		}
+		w.Write([]byte(pages[page]))
+	}))
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	t.Run("WalksAllPages", func(t *testing.T) {
+		requested = nil
+		results, err := client.SearchAll(ctx, &SearchParams{Q: "election"}, 0)
+		if err != nil {
+			t.Fatalf("SearchAll failed: %v", err)
+		}
+		if fmt.Sprint(requested) != "[1 2 3]" {
+			t.Errorf("requested pages = %v, want [1 2 3]", requested)
+		}
+		if len(results.Events) != 3 || len(results.Tags) != 1 || len(results.Profiles) != 2 {
+			t.Errorf("got %d events, %d tags, %d profiles; want 3, 1, 2",
+				len(results.Events), len(results.Tags), len(results.Profiles))
+		}
+		if results.Pages != 3 || results.HasMore || results.TotalResults != 5 {
+			t.Errorf("unexpected summary: pages=%d hasMore=%v total=%d", results.Pages, results.HasMore, results.TotalResults)
+		}
+	})
+
+	t.Run("PageBudget", func(t *testing.T) {
+		requested = nil
+		results, err := client.SearchAll(ctx, &SearchParams{Q: "election"}, 2)
+		if err != nil {
+			t.Fatalf("SearchAll failed: %v", err)
+		}
+		if results.Pages != 2 || !results.HasMore {
+			t.Errorf("pages=%d hasMore=%v, want 2 true", results.Pages, results.HasMore)
+		}
+	})
+
+	t.Run("DuplicateOnlyPage", func(t *testing.T) {
+		requested = nil
+		results, err := client.SearchAll(ctx, &SearchParams{Q: "duplicates"}, 0)
+		if err != nil {
+			t.Fatalf("SearchAll failed: %v", err)
+		}
+		if fmt.Sprint(requested) != "[1 2 3]" || len(results.Events) != 2 || results.HasMore {
+			t.Errorf("requested %v, got %d events, hasMore=%v; want all 3 pages, 2 events, false",
+				requested, len(results.Events), results.HasMore)
+		}
+
+		requested = nil
+		results, err = client.SearchAll(ctx, &SearchParams{Q: "duplicates"}, 2)
+		if err != nil {
+			t.Fatalf("SearchAll failed: %v", err)
+		}
+		if len(results.Events) != 1 || !results.HasMore {
+			t.Errorf("got %d events, hasMore=%v; want 1 event and hasMore=true when the budget runs out",
+				len(results.Events), results.HasMore)
+		}
+	})
+
+	t.Run("RequiresQuery", func(t *testing.T) {
+		if _, err := client.SearchAll(ctx, &SearchParams{}, 1); err == nil {
+			t.Error("expected error for empty query")
+		}
+	})
+}
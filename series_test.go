@@ -2,12 +2,11 @@ package polymarketgamma
 
 import (
 	"context"
-	"net/http"
 	"testing"
 )
 
 func TestAllSeriesFunctions(t *testing.T) {
-	client := NewClient(http.DefaultClient)
+	client := newRecordedClient(t)
 	ctx := context.Background()
 
 	// Step 1: Get series
@@ -2,12 +2,11 @@ package polymarketgamma
 
 import (
 	"context"
-	"net/http"
 	"testing"
 )
 
 func TestGetTeams(t *testing.T) {
-	client := NewClient(http.DefaultClient)
+	client := newRecordedClient(t)
 	ctx := context.Background()
 
 	// Test fetching teams without parameters
@@ -73,7 +72,7 @@ func TestGetTeams(t *testing.T) {
 }
 
 func TestGetSportsMetadata(t *testing.T) {
-	client := NewClient(http.DefaultClient)
+	client := newRecordedClient(t)
 	ctx := context.Background()
 
 	metadata, err := client.GetSportsMetadata(ctx, )
@@ -0,0 +1,204 @@
+package polymarketgamma
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+)
+
+// ErrResponseTooLarge is returned when a response body exceeds the size set with WithMaxResponseSize
+var ErrResponseTooLarge = errors.New("response too large")
+
+// WithMaxResponseSize fails requests whose response body exceeds n bytes with ErrResponseTooLarge
+// instead of reading it all into memory (0 = no limit). It applies to regular and streaming calls.
+func WithMaxResponseSize(n int64) Option {
+	return func(c *Client) {
+		c.maxResponseSize = n
+	}
+}
+
+// limitBody wraps body so reads fail with ErrResponseTooLarge past the configured maximum size
+func (c *Client) limitBody(body io.Reader) io.Reader {
+	if c.maxResponseSize <= 0 {
+		return body
+	}
+	return &limitedReader{r: body, limit: c.maxResponseSize, remaining: c.maxResponseSize}
+}
+
+type limitedReader struct {
+	r         io.Reader
+	limit     int64
+	remaining int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	// Read one byte past the limit to tell "exactly at the limit" from "over it"
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+	n, err := l.r.Read(p)
+	if int64(n) > l.remaining {
+		n = int(l.remaining)
+		l.remaining = 0
+		return n, fmt.Errorf("%w: more than %d bytes", ErrResponseTooLarge, l.limit)
+	}
+	l.remaining -= int64(n)
+	return n, err
+}
+
+// StreamMarkets fetches a single page of markets and decodes it one market at a time straight from the
+// response body, so large pages never sit in memory as a whole. The in-memory cache is bypassed.
+// With partial decoding enabled, elements that fail to decode are yielded as *ElementDecodeError and
+// iteration continues; any other error ends the iteration.
+func (c *Client) StreamMarkets(ctx context.Context, params *GetMarketsParams) iter.Seq2[*Market, error] {
+	return streamList[*Market](ctx, c, "StreamMarkets", params, marketsPath(params))
+}
+
+// StreamEvents fetches a single page of events and decodes it one event at a time straight from the
+// response body. Use it for large pages requested with IncludeChat or IncludeTemplate.
+// Errors are reported as for StreamMarkets.
+func (c *Client) StreamEvents(ctx context.Context, params *GetEventsParams) iter.Seq2[Event, error] {
+	return streamList[Event](ctx, c, "StreamEvents", params, eventsPath(params))
+}
+
+// StreamSeries fetches a single page of series and decodes it one series at a time straight from the
+// response body. Errors are reported as for StreamMarkets.
+func (c *Client) StreamSeries(ctx context.Context, params *GetSeriesParams) iter.Seq2[Series, error] {
+	return streamList[Series](ctx, c, "StreamSeries", params, seriesPath(params))
+}
+
+// openStream sends a GET request with retries through the interceptor chain and returns the unread response.
+// Retries only cover the request itself; errors while streaming the body are not retried.
+// If an interceptor supplies a Body, it is streamed instead of the API response.
+func (c *Client) openStream(ctx context.Context, call *Call) (*http.Response, context.CancelFunc, error) {
+	type opened struct {
+		resp   *http.Response
+		cancel context.CancelFunc
+	}
+
+	var o opened
+	terminal := func(ctx context.Context, call *Call) (*Response, error) {
+		var attempts int
+		var err error
+		o, err = withRetry(ctx, c.retryPolicy, func() (opened, error) {
+			attempts++
+			resp, cancel, err := c.openAttempt(ctx, call)
+			return opened{resp: resp, cancel: cancel}, err
+		})
+		return &Response{StatusCode: statusOf(err), Attempts: attempts}, err
+	}
+
+	resp, err := c.buildHandler(terminal)(ctx, call)
+	if err == nil && resp != nil && resp.Body != nil {
+		if o.resp != nil {
+			o.resp.Body.Close()
+			o.cancel()
+		}
+		o = opened{
+			resp:   &http.Response{StatusCode: resp.StatusCode, Body: io.NopCloser(bytes.NewReader(resp.Body))},
+			cancel: func() {},
+		}
+	}
+	if err == nil && o.resp == nil {
+		err = fmt.Errorf("interceptor answered %s without a response body", call.Endpoint)
+	}
+	if err != nil {
+		if o.resp != nil {
+			o.resp.Body.Close()
+			o.cancel()
+		}
+		return nil, nil, err
+	}
+	return o.resp, o.cancel, nil
+}
+
+// streamList decodes a JSON array response element by element, yielding each decoded item
+func streamList[T any](ctx context.Context, c *Client, endpoint string, params validator, path string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		// The span covers the whole iteration, ending when the caller stops or the stream is exhausted
+		ctx, span := c.startSpan(ctx, endpoint)
+		span.SetAttributes(
+			Attribute{Key: "gamma.endpoint", Value: endpoint},
+			Attribute{Key: "http.method", Value: http.MethodGet},
+			Attribute{Key: "url.path", Value: path},
+		)
+		var items int
+		var err error
+		var decodeFailed bool
+		defer func() {
+			if decodeFailed {
+				c.recordDecodeError(path)
+			}
+			span.SetAttributes(Attribute{Key: "gamma.items", Value: items})
+			endSpan(span, err)
+		}()
+
+		if err = c.checkParams(params); err != nil {
+			yield(zero, err)
+			return
+		}
+
+		resp, cancel, err := c.openStream(ctx, newCall(endpoint, params, path))
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		defer cancel()
+		defer resp.Body.Close()
+
+		dec := json.NewDecoder(c.limitBody(resp.Body))
+
+		if tok, tokErr := dec.Token(); tokErr != nil || tok != json.Delim('[') {
+			if tokErr == nil {
+				tokErr = fmt.Errorf("expected JSON array, got %v", tok)
+			}
+			err = fmt.Errorf("failed to parse response: %w", tokErr)
+			decodeFailed = !errors.Is(err, ErrResponseTooLarge)
+			yield(zero, err)
+			return
+		}
+
+		for index := 0; dec.More(); index++ {
+			// Only one raw element is held at a time
+			var raw json.RawMessage
+			if err = dec.Decode(&raw); err != nil {
+				err = fmt.Errorf("failed to parse response: %w", err)
+				decodeFailed = !errors.Is(err, ErrResponseTooLarge)
+				yield(zero, err)
+				return
+			}
+
+			var item T
+			if decodeErr := c.decode(path, raw, &item); decodeErr != nil {
+				decodeFailed = true
+				if !c.partialDecoding {
+					err = fmt.Errorf("failed to parse response: element %d: %w", index, decodeErr)
+					yield(zero, err)
+					return
+				}
+				if !yield(zero, &ElementDecodeError{Index: index, Raw: raw, Err: decodeErr}) {
+					return
+				}
+				continue
+			}
+
+			items++
+			if !yield(item, nil) {
+				return
+			}
+		}
+
+		if _, err = dec.Token(); err != nil {
+			err = fmt.Errorf("failed to parse response: %w", err)
+			decodeFailed = !errors.Is(err, ErrResponseTooLarge)
+			yield(zero, err)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package polymarketgamma
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestStreamEvents(t *testing.T) {
+	var lastQuery atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lastQuery.Store(r.URL.RawQuery)
+		w.Write([]byte(`[{"id":"1","markets":[{"id":"10"}]}, {"id":"2"}, {"id":"3"}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL))
+	includeChat := true
+
+	var ids []string
+	for event, err := range client.StreamEvents(context.Background(), &GetEventsParams{IncludeChat: &includeChat}) {
+		if err != nil {
+			t.Fatalf("StreamEvents failed: %v", err)
+		}
+		ids = append(ids, event.ID)
+	}
+	if strings.Join(ids, ",") != "1,2,3" {
+		t.Errorf("ids = %v, want [1 2 3]", ids)
+	}
+	if q := lastQuery.Load(); q != "include_chat=true" {
+		t.Errorf("query = %v, want include_chat=true", q)
+	}
+
+	// Breaking early stops decoding
+	count := 0
+	for range client.StreamEvents(context.Background(), nil) {
+		count++
+		break
+	}
+	if count != 1 {
+		t.Errorf("count = %d, want 1", count)
+	}
+}
+
+func TestStreamMarketsElementErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"id":"1"},{"id":"2","closed":"x"},{"id":"3"}]`))
+	}))
+	defer server.Close()
+	ctx := context.Background()
+
+	// Without partial decoding the first bad element ends the stream
+	var got []string
+	var streamErr error
+	for market, err := range NewClient(nil, WithBaseURL(server.URL)).StreamMarkets(ctx, nil) {
+		if err != nil {
+			streamErr = err
+			break
+		}
+		got = append(got, market.ID)
+	}
+	if streamErr == nil || len(got) != 1 {
+		t.Errorf("got %v, err %v; want one market then an error", got, streamErr)
+	}
+
+	got = nil
+	var elementErrs []*ElementDecodeError
+	for market, err := range NewClient(nil, WithBaseURL(server.URL), WithPartialDecoding()).StreamMarkets(ctx, nil) {
+		var elemErr *ElementDecodeError
+		if errors.As(err, &elemErr) {
+			elementErrs = append(elementErrs, elemErr)
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got = append(got, market.ID)
+	}
+	if len(got) != 2 || len(elementErrs) != 1 || elementErrs[0].Index != 1 {
+		t.Errorf("got %v, element errors %v", got, elementErrs)
+	}
+}
+
+func TestStreamRetriesBeforeBody(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`[{"id":"1"}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL), WithRetryPolicy(RetryPolicy{
+		MaxAttempts:          2,
+		RetryableStatusCodes: []int{http.StatusServiceUnavailable},
+	}))
+
+	for _, err := range client.StreamSeries(context.Background(), nil) {
+		if err != nil {
+			t.Fatalf("StreamSeries failed: %v", err)
+		}
+	}
+	if requests.Load() != 2 {
+		t.Errorf("requests = %d, want 2", requests.Load())
+	}
+}
+
+func TestMaxResponseSize(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		var b strings.Builder
+		b.WriteString("[")
+		for i := range 100 {
+			if i > 0 {
+				b.WriteString(",")
+			}
+			fmt.Fprintf(&b, `{"id":"%d"}`, i)
+		}
+		b.WriteString("]")
+		w.Write([]byte(b.String()))
+	}))
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL), WithMaxResponseSize(256),
+		WithRetryPolicy(DefaultRetryPolicy()))
+	ctx := context.Background()
+
+	if _, err := client.GetTags(ctx, nil); !errors.Is(err, ErrResponseTooLarge) {
+		t.Errorf("GetTags error = %v, want ErrResponseTooLarge", err)
+	}
+	if requests.Load() != 1 {
+		t.Errorf("requests = %d, want 1 (oversized responses are not retried)", requests.Load())
+	}
+
+	var streamed int
+	var streamErr error
+	for _, err := range client.StreamMarkets(ctx, nil) {
+		if err != nil {
+			streamErr = err
+			break
+		}
+		streamed++
+	}
+	if !errors.Is(streamErr, ErrResponseTooLarge) || streamed == 0 {
+		t.Errorf("streamed %d, err %v; want some markets then ErrResponseTooLarge", streamed, streamErr)
+	}
+
+	// Responses within the limit are unaffected
+	roomy := NewClient(nil, WithBaseURL(server.URL), WithMaxResponseSize(1<<20))
+	if tags, err := roomy.GetTags(ctx, nil); err != nil || len(tags) != 100 {
+		t.Errorf("GetTags = %d tags, %v", len(tags), err)
+	}
+}
@@ -2,12 +2,11 @@ package polymarketgamma
 
 import (
 	"context"
-	"net/http"
 	"testing"
 )
 
 func TestGetTags(t *testing.T) {
-	client := NewClient(http.DefaultClient)
+	client := newRecordedClient(t)
 	ctx := context.Background()
 
 	// Test fetching tags without parameters
@@ -73,7 +72,7 @@ func TestGetTags(t *testing.T) {
 }
 
 func TestGetTagByID(t *testing.T) {
-	client := NewClient(http.DefaultClient)
+	client := newRecordedClient(t)
 	ctx := context.Background()
 
 	// First get a list of tags to test with
@@ -120,7 +119,7 @@ func TestGetTagByID(t *testing.T) {
 }
 
 func TestGetTagBySlug(t *testing.T) {
-	client := NewClient(http.DefaultClient)
+	client := newRecordedClient(t)
 	ctx := context.Background()
 
 	// First get a list of tags to test with
@@ -167,7 +166,7 @@ func TestGetTagBySlug(t *testing.T) {
 }
 
 func TestGetTagByIDAndSlugConsistency(t *testing.T) {
-	client := NewClient(http.DefaultClient)
+	client := newRecordedClient(t)
 	ctx := context.Background()
 
 	// Get a tag to test with
@@ -209,7 +208,7 @@ func TestGetTagByIDAndSlugConsistency(t *testing.T) {
 }
 
 func TestGetRelatedTagsByID(t *testing.T) {
-	client := NewClient(http.DefaultClient)
+	client := newRecordedClient(t)
 	ctx := context.Background()
 
 	// Get a tag to test with
@@ -254,7 +253,7 @@ func TestGetRelatedTagsByID(t *testing.T) {
 }
 
 func TestGetRelatedTagsBySlug(t *testing.T) {
-	client := NewClient(http.DefaultClient)
+	client := newRecordedClient(t)
 	ctx := context.Background()
 
 	// Get a tag to test with
@@ -280,7 +279,7 @@ func TestGetRelatedTagsBySlug(t *testing.T) {
 }
 
 func TestGetRelatedTagsDetailByID(t *testing.T) {
-	client := NewClient(http.DefaultClient)
+	client := newRecordedClient(t)
 	ctx := context.Background()
 
 	// Get a tag to test with
@@ -325,7 +324,7 @@ func TestGetRelatedTagsDetailByID(t *testing.T) {
 }
 
 func TestGetRelatedTagsDetailBySlug(t *testing.T) {
-	client := NewClient(http.DefaultClient)
+	client := newRecordedClient(t)
 	ctx := context.Background()
 
 	// Get a tag to test with
@@ -351,7 +350,7 @@ func TestGetRelatedTagsDetailBySlug(t *testing.T) {
 }
 
 func TestRelatedTagsConsistency(t *testing.T) {
-	client := NewClient(http.DefaultClient)
+	client := newRecordedClient(t)
 	ctx := context.Background()
 
 	// Get a tag to test with
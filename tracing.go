@@ -0,0 +1,225 @@
+package polymarketgamma
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"net/http"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// Tracer starts spans around Client calls. Implement it to bridge an existing tracing library such as
+// OpenTelemetry; the parent span, if any, is found in ctx and the returned context carries the new span.
+type Tracer interface {
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is a single traced operation
+type Span interface {
+	SetAttributes(attrs ...Attribute)
+	RecordError(err error)
+	// SpanContext identifies the span; a valid one is propagated as a W3C traceparent header
+	SpanContext() SpanContext
+	End()
+}
+
+// Attribute is a span attribute. Values are strings, ints, bools, float64s or time.Durations.
+type Attribute struct {
+	Key   string
+	Value any
+}
+
+// SpanContext holds the W3C trace context identifiers of a span
+type SpanContext struct {
+	TraceID [16]byte
+	SpanID  [8]byte
+	Sampled bool
+}
+
+// IsValid reports whether both IDs are non-zero
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID != [16]byte{} && sc.SpanID != [8]byte{}
+}
+
+// TraceParent formats the span context as a W3C traceparent header value
+func (sc SpanContext) TraceParent() string {
+	flags := "00"
+	if sc.Sampled {
+		flags = "01"
+	}
+	return fmt.Sprintf("00-%s-%s-%s", hex.EncodeToString(sc.TraceID[:]), hex.EncodeToString(sc.SpanID[:]), flags)
+}
+
+// WithTracer creates a span for every Client method call, with child spans for each HTTP attempt and for
+// decoding. Attempt spans are propagated to the API as W3C traceparent headers.
+func WithTracer(tracer Tracer) Option {
+	return func(c *Client) {
+		c.tracer = tracer
+	}
+}
+
+// startSpan starts a span with the client's tracer, or a no-op span if tracing is disabled
+func (c *Client) startSpan(ctx context.Context, name string) (context.Context, Span) {
+	if c.tracer == nil {
+		return ctx, noopSpan{}
+	}
+	return c.tracer.Start(ctx, name)
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttributes(...Attribute) {}
+func (noopSpan) RecordError(error)          {}
+func (noopSpan) SpanContext() SpanContext   { return SpanContext{} }
+func (noopSpan) End()                       {}
+
+// endSpan records err, if any, and ends span
+func endSpan(span Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+	}
+	span.End()
+}
+
+// traced performs a call and decodes its response inside a span named after the Client method
+func traced[T any](ctx context.Context, c *Client, endpoint string, params any, path string, decode func([]byte) (T, error)) (result T, err error) {
+	ctx, span := c.startSpan(ctx, endpoint)
+	span.SetAttributes(
+		Attribute{Key: "gamma.endpoint", Value: endpoint},
+		Attribute{Key: "http.method", Value: http.MethodGet},
+		Attribute{Key: "url.path", Value: path},
+	)
+	defer func() { endSpan(span, err) }()
+
+	resp, err := c.doRequest(ctx, endpoint, params, path)
+	if resp != nil {
+		span.SetAttributes(
+			Attribute{Key: "http.status_code", Value: resp.StatusCode},
+			Attribute{Key: "gamma.attempts", Value: resp.Attempts},
+			Attribute{Key: "gamma.cache_hit", Value: resp.Cached},
+		)
+	}
+	if err != nil {
+		return result, err
+	}
+
+	_, decodeSpan := c.startSpan(ctx, "decode")
+	result, err = decode(resp.Body)
+	items := itemCount(result)
+	decodeSpan.SetAttributes(Attribute{Key: "gamma.items", Value: items}, Attribute{Key: "gamma.bytes", Value: len(resp.Body)})
+	endSpan(decodeSpan, err)
+
+	span.SetAttributes(Attribute{Key: "gamma.items", Value: items})
+	return result, err
+}
+
+// itemCount returns the number of decoded items: the length of a slice, otherwise one
+func itemCount(v any) int {
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Slice {
+		return rv.Len()
+	}
+	return 1
+}
+
+// MemoryTracer records spans in memory, for tests
+type MemoryTracer struct {
+	mu    sync.Mutex
+	spans []RecordedSpan
+}
+
+// RecordedSpan is a finished span captured by MemoryTracer
+type RecordedSpan struct {
+	Name       string
+	Context    SpanContext
+	Parent     SpanContext // Zero for root spans
+	Attributes map[string]any
+	Err        error
+	Start      time.Time
+	End        time.Time
+}
+
+// NewMemoryTracer creates an empty in-memory tracer
+func NewMemoryTracer() *MemoryTracer {
+	return &MemoryTracer{}
+}
+
+type memorySpanKey struct{}
+
+// Start implements Tracer
+func (t *MemoryTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	span := &memorySpan{
+		tracer: t,
+		rec: RecordedSpan{
+			Name:       name,
+			Attributes: make(map[string]any),
+			Start:      time.Now(),
+		},
+	}
+
+	if parent, ok := ctx.Value(memorySpanKey{}).(*memorySpan); ok {
+		span.rec.Parent = parent.rec.Context
+		span.rec.Context.TraceID = parent.rec.Context.TraceID
+	} else {
+		rand.Read(span.rec.Context.TraceID[:])
+	}
+	rand.Read(span.rec.Context.SpanID[:])
+	span.rec.Context.Sampled = true
+
+	return context.WithValue(ctx, memorySpanKey{}, span), span
+}
+
+// Spans returns the finished spans in the order they ended
+func (t *MemoryTracer) Spans() []RecordedSpan {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]RecordedSpan(nil), t.spans...)
+}
+
+// Reset discards all recorded spans
+func (t *MemoryTracer) Reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.spans = nil
+}
+
+type memorySpan struct {
+	tracer *MemoryTracer
+
+	mu  sync.Mutex
+	rec RecordedSpan
+}
+
+func (s *memorySpan) SetAttributes(attrs ...Attribute) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, attr := range attrs {
+		s.rec.Attributes[attr.Key] = attr.Value
+	}
+}
+
+func (s *memorySpan) RecordError(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rec.Err = err
+}
+
+func (s *memorySpan) SpanContext() SpanContext {
+	return s.rec.Context
+}
+
+func (s *memorySpan) End() {
+	s.mu.Lock()
+	rec := s.rec
+	rec.Attributes = maps.Clone(s.rec.Attributes)
+	s.mu.Unlock()
+
+	rec.End = time.Now()
+
+	s.tracer.mu.Lock()
+	defer s.tracer.mu.Unlock()
+	s.tracer.spans = append(s.tracer.spans, rec)
+}
@@ -0,0 +1,202 @@
+package polymarketgamma
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestTracing(t *testing.T) {
+	var mu sync.Mutex
+	var traceparents []string
+	var failures int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		traceparents = append(traceparents, r.Header.Get("traceparent"))
+
+		switch r.URL.Path {
+		case "/events":
+			failures++
+			if failures == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Write([]byte(`[{"id":"1"}]`))
+		case "/tags/missing":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.Write([]byte(`[{"id":"1"},{"id":"2"}]`))
+		}
+	}))
+	defer server.Close()
+
+	tracer := NewMemoryTracer()
+	client := NewClient(nil, WithBaseURL(server.URL), WithTracer(tracer),
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 2, RetryableStatusCodes: []int{http.StatusServiceUnavailable}}),
+	)
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		tracer.Reset()
+		traceparents = nil
+
+		if _, err := client.GetMarkets(ctx, &GetMarketsParams{Limit: 2}); err != nil {
+			t.Fatalf("GetMarkets failed: %v", err)
+		}
+
+		spans := tracer.Spans()
+		if len(spans) != 3 {
+			t.Fatalf("spans = %+v", spans)
+		}
+		attempt, decode, method := spans[0], spans[1], spans[2]
+
+		if method.Name != "GetMarkets" || method.Parent.IsValid() || method.Err != nil {
+			t.Errorf("method span = %+v", method)
+		}
+		for key, want := range map[string]any{
+			"gamma.endpoint":   "GetMarkets",
+			"url.path":         "/markets?limit=2",
+			"http.status_code": http.StatusOK,
+			"gamma.attempts":   1,
+			"gamma.cache_hit":  false,
+			"gamma.items":      2,
+		} {
+			if got := method.Attributes[key]; got != want {
+				t.Errorf("method span %s = %v, want %v", key, got, want)
+			}
+		}
+
+		if attempt.Name != "HTTP GET" || attempt.Parent != method.Context || attempt.Context.TraceID != method.Context.TraceID {
+			t.Errorf("attempt span = %+v", attempt)
+		}
+		if decode.Name != "decode" || decode.Parent != method.Context || decode.Attributes["gamma.bytes"] != 23 {
+			t.Errorf("decode span = %+v", decode)
+		}
+
+		if len(traceparents) != 1 || traceparents[0] != attempt.Context.TraceParent() {
+			t.Errorf("traceparent = %v, want %s", traceparents, attempt.Context.TraceParent())
+		}
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		tracer.Reset()
+		traceparents = nil
+
+		if _, err := client.GetEvents(ctx, nil); err != nil {
+			t.Fatalf("GetEvents failed: %v", err)
+		}
+
+		var attempts []RecordedSpan
+		var method RecordedSpan
+		for _, span := range tracer.Spans() {
+			switch span.Name {
+			case "HTTP GET":
+				attempts = append(attempts, span)
+			case "GetEvents":
+				method = span
+			}
+		}
+		if len(attempts) != 2 || attempts[0].Err == nil || attempts[1].Err != nil {
+			t.Fatalf("attempt spans = %+v", attempts)
+		}
+		if attempts[0].Attributes["http.status_code"] != http.StatusServiceUnavailable {
+			t.Errorf("first attempt status = %v", attempts[0].Attributes["http.status_code"])
+		}
+		if method.Attributes["gamma.attempts"] != 2 {
+			t.Errorf("gamma.attempts = %v, want 2", method.Attributes["gamma.attempts"])
+		}
+		if len(traceparents) != 2 || traceparents[0] == traceparents[1] {
+			t.Errorf("traceparents = %v, want one per attempt", traceparents)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tracer.Reset()
+
+		if _, err := client.GetTagByID(ctx, "missing", nil); err == nil {
+			t.Fatal("GetTagByID succeeded, want 404")
+		}
+
+		spans := tracer.Spans()
+		if len(spans) != 2 {
+			t.Fatalf("spans = %+v, want attempt and method spans", spans)
+		}
+		method := spans[1]
+		if method.Name != "GetTagByID" || method.Err == nil || method.Attributes["http.status_code"] != http.StatusNotFound {
+			t.Errorf("method span = %+v", method)
+		}
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		tracer.Reset()
+
+		for _, err := range client.StreamMarkets(ctx, nil) {
+			if err != nil {
+				t.Fatalf("StreamMarkets failed: %v", err)
+			}
+		}
+
+		spans := tracer.Spans()
+		if len(spans) != 2 {
+			t.Fatalf("spans = %+v", spans)
+		}
+		if method := spans[1]; method.Name != "StreamMarkets" || method.Attributes["gamma.items"] != 2 || spans[0].Parent != method.Context {
+			t.Errorf("stream spans = %+v", spans)
+		}
+	})
+
+	t.Run("parent", func(t *testing.T) {
+		tracer.Reset()
+
+		ctx, parent := tracer.Start(ctx, "caller")
+		client.GetMarkets(ctx, nil)
+		parent.End()
+
+		spans := tracer.Spans()
+		method := spans[len(spans)-2]
+		if method.Name != "GetMarkets" || method.Parent != parent.SpanContext() {
+			t.Errorf("method span = %+v, want child of caller", method)
+		}
+	})
+}
+
+func TestTracingDisabled(t *testing.T) {
+	var traceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(nil, WithBaseURL(server.URL))
+	if _, err := client.GetMarkets(context.Background(), nil); err != nil {
+		t.Fatalf("GetMarkets failed: %v", err)
+	}
+	if traceparent != "" {
+		t.Errorf("traceparent = %q, want none without a tracer", traceparent)
+	}
+}
+
+func TestTraceParent(t *testing.T) {
+	sc := SpanContext{Sampled: true}
+	for i := range sc.TraceID {
+		sc.TraceID[i] = byte(i + 1)
+	}
+	for i := range sc.SpanID {
+		sc.SpanID[i] = byte(0xa0 + i)
+	}
+
+	if got, want := sc.TraceParent(), "00-0102030405060708090a0b0c0d0e0f10-a0a1a2a3a4a5a6a7-01"; got != want {
+		t.Errorf("TraceParent() = %q, want %q", got, want)
+	}
+	sc.Sampled = false
+	if got := sc.TraceParent(); got[len(got)-2:] != "00" {
+		t.Errorf("unsampled TraceParent() = %q", got)
+	}
+	if (SpanContext{}).IsValid() {
+		t.Error("zero SpanContext is valid")
+	}
+}
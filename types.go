@@ -2,6 +2,9 @@ package polymarketgamma
 
 import (
 	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -207,6 +210,130 @@ func (sa StringOrArray) MarshalJSON() ([]byte, error) {
 	return json.Marshal([]string(sa))
 }
 
+// FlexFloat is a float64 that can unmarshal from a JSON number, a numeric string, an empty string or null
+// API returns some numeric fields as numbers on one record and as strings on the next
+type FlexFloat float64
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (f *FlexFloat) UnmarshalJSON(b []byte) error {
+	s, ok, err := flexNumber(b)
+	if err != nil || !ok {
+		*f = 0
+		return err
+	}
+
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return fmt.Errorf("invalid number %s: %w", b, err)
+	}
+	// ParseFloat accepts "NaN" and "Inf", which json.Marshal can't encode again
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return fmt.Errorf("invalid number %s", b)
+	}
+	*f = FlexFloat(v)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (f FlexFloat) MarshalJSON() ([]byte, error) {
+	return json.Marshal(float64(f))
+}
+
+// Float64 returns the value as a float64
+func (f FlexFloat) Float64() float64 {
+	return float64(f)
+}
+
+// FlexInt is an int that can unmarshal from a JSON number, a numeric string, an empty string or null.
+// Integral floats such as 3.0 are accepted; fractional values are an error.
+type FlexInt int
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (i *FlexInt) UnmarshalJSON(b []byte) error {
+	s, ok, err := flexNumber(b)
+	if err != nil || !ok {
+		*i = 0
+		return err
+	}
+
+	if v, err := strconv.ParseInt(s, 10, 0); err == nil {
+		*i = FlexInt(v)
+		return nil
+	}
+
+	// float64(math.MaxInt) rounds up to the first value out of range, so compare with >=
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil || v != math.Trunc(v) || v >= -float64(math.MinInt) || v < float64(math.MinInt) {
+		return fmt.Errorf("invalid integer %s", b)
+	}
+	*i = FlexInt(v)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (i FlexInt) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int(i))
+}
+
+// Int returns the value as an int
+func (i FlexInt) Int() int {
+	return int(i)
+}
+
+// FlexString is a string that can unmarshal from a JSON string, a number (kept verbatim, e.g. "1234.5") or null
+type FlexString string
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (fs *FlexString) UnmarshalJSON(b []byte) error {
+	raw := strings.TrimSpace(string(b))
+	switch {
+	case raw == "null":
+		*fs = ""
+	case strings.HasPrefix(raw, `"`):
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		*fs = FlexString(s)
+	case raw == "true" || raw == "false":
+		*fs = FlexString(raw)
+	default:
+		if _, err := strconv.ParseFloat(raw, 64); err != nil {
+			return fmt.Errorf("cannot unmarshal %s into FlexString", b)
+		}
+		*fs = FlexString(raw)
+	}
+	return nil
+}
+
+// String returns the value as a string
+func (fs FlexString) String() string {
+	return string(fs)
+}
+
+// flexNumber extracts the numeric text of a JSON number or string.
+// ok is false for null, empty and whitespace-only strings.
+func flexNumber(b []byte) (s string, ok bool, err error) {
+	raw := strings.TrimSpace(string(b))
+	if raw == "null" {
+		return "", false, nil
+	}
+
+	if strings.HasPrefix(raw, `"`) {
+		if err := json.Unmarshal(b, &raw); err != nil {
+			return "", false, err
+		}
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			return "", false, nil
+		}
+	} else if raw == "" || raw == "true" || raw == "false" || raw[0] == '{' || raw[0] == '[' {
+		return "", false, fmt.Errorf("cannot unmarshal %s into a number", b)
+	}
+
+	return raw, true, nil
+}
+
 // HealthResponse represents the response from the health check endpoint
 type HealthResponse struct {
 	Data string `json:"data"`
@@ -216,7 +343,7 @@ type HealthResponse struct {
 type GetEventsParams struct {
 	Limit           int             `json:"limit,omitempty"`  // Maximum number of events to return
 	Offset          int             `json:"offset,omitempty"` // Pagination offset
-	Order           string          `json:"order,omitempty"`  // Comma-separated list of fields to order by
+	Order           OrderField      `json:"order,omitempty"`  // Comma-separated list of fields to order by
 	Ascending       *bool           `json:"ascending,omitempty"`
 	ID              []int           `json:"id,omitempty"`
 	Slug            []string        `json:"slug,omitempty"`
@@ -227,7 +354,7 @@ type GetEventsParams struct {
 	CYOM            *bool           `json:"cyom,omitempty"`
 	IncludeChat     *bool           `json:"include_chat,omitempty"`
 	IncludeTemplate *bool           `json:"include_template,omitempty"`
-	Recurrence      string          `json:"recurrence,omitempty"`
+	Recurrence      Recurrence      `json:"recurrence,omitempty"`
 	Closed          *bool           `json:"closed,omitempty"`
 	StartDateMin    *NormalizedTime `json:"start_date_min,omitempty"` // ISO 8601 date-time
 	StartDateMax    *NormalizedTime `json:"start_date_max,omitempty"` // ISO 8601 date-time
@@ -237,33 +364,33 @@ type GetEventsParams struct {
 
 // GetMarketsParams represents parameters for fetching markets list
 type GetMarketsParams struct {
-	Limit               int             `json:"limit,omitempty"`
-	Offset              int             `json:"offset,omitempty"`
-	Order               string          `json:"order,omitempty"`          // Comma-separated list of fields to order by
-	Ascending           *bool           `json:"ascending,omitempty"`      // Use pointer to distinguish between false and unset
-	ID                  []int           `json:"id,omitempty"`             // Market IDs
-	Slug                []string        `json:"slug,omitempty"`           // Market slugs
-	ClobTokenIDs        []string        `json:"clob_token_ids,omitempty"` // CLOB token IDs
-	ConditionIDs        []string        `json:"condition_ids,omitempty"`  // Condition IDs
-	MarketMakerAddress  []string        `json:"market_maker_address,omitempty"`
-	LiquidityNumMin     *float64        `json:"liquidity_num_min,omitempty"`
-	LiquidityNumMax     *float64        `json:"liquidity_num_max,omitempty"`
-	VolumeNumMin        *float64        `json:"volume_num_min,omitempty"`
-	VolumeNumMax        *float64        `json:"volume_num_max,omitempty"`
-	StartDateMin        *NormalizedTime `json:"start_date_min,omitempty"` // ISO 8601 date-time
-	StartDateMax        *NormalizedTime `json:"start_date_max,omitempty"` // ISO 8601 date-time
-	EndDateMin          *NormalizedTime `json:"end_date_min,omitempty"`   // ISO 8601 date-time
-	EndDateMax          *NormalizedTime `json:"end_date_max,omitempty"`   // ISO 8601 date-time
-	TagID               *int            `json:"tag_id,omitempty"`
-	RelatedTags         *bool           `json:"related_tags,omitempty"`
-	CYOM                *bool           `json:"cyom,omitempty"`
-	UMAResolutionStatus string          `json:"uma_resolution_status,omitempty"`
-	GameID              string          `json:"game_id,omitempty"`
-	SportsMarketTypes   []string        `json:"sports_market_types,omitempty"`
-	RewardsMinSize      *float64        `json:"rewards_min_size,omitempty"`
-	QuestionIDs         []string        `json:"question_ids,omitempty"`
-	IncludeTag          *bool           `json:"include_tag,omitempty"`
-	Closed              *bool           `json:"closed,omitempty"`
+	Limit               int                 `json:"limit,omitempty"`
+	Offset              int                 `json:"offset,omitempty"`
+	Order               OrderField          `json:"order,omitempty"`          // Comma-separated list of fields to order by
+	Ascending           *bool               `json:"ascending,omitempty"`      // Use pointer to distinguish between false and unset
+	ID                  []int               `json:"id,omitempty"`             // Market IDs
+	Slug                []string            `json:"slug,omitempty"`           // Market slugs
+	ClobTokenIDs        []string            `json:"clob_token_ids,omitempty"` // CLOB token IDs
+	ConditionIDs        []string            `json:"condition_ids,omitempty"`  // Condition IDs
+	MarketMakerAddress  []string            `json:"market_maker_address,omitempty"`
+	LiquidityNumMin     *float64            `json:"liquidity_num_min,omitempty"`
+	LiquidityNumMax     *float64            `json:"liquidity_num_max,omitempty"`
+	VolumeNumMin        *float64            `json:"volume_num_min,omitempty"`
+	VolumeNumMax        *float64            `json:"volume_num_max,omitempty"`
+	StartDateMin        *NormalizedTime     `json:"start_date_min,omitempty"` // ISO 8601 date-time
+	StartDateMax        *NormalizedTime     `json:"start_date_max,omitempty"` // ISO 8601 date-time
+	EndDateMin          *NormalizedTime     `json:"end_date_min,omitempty"`   // ISO 8601 date-time
+	EndDateMax          *NormalizedTime     `json:"end_date_max,omitempty"`   // ISO 8601 date-time
+	TagID               *int                `json:"tag_id,omitempty"`
+	RelatedTags         *bool               `json:"related_tags,omitempty"`
+	CYOM                *bool               `json:"cyom,omitempty"`
+	UMAResolutionStatus UMAResolutionStatus `json:"uma_resolution_status,omitempty"`
+	GameID              string              `json:"game_id,omitempty"`
+	SportsMarketTypes   []SportsMarketType  `json:"sports_market_types,omitempty"`
+	RewardsMinSize      *float64            `json:"rewards_min_size,omitempty"`
+	QuestionIDs         []string            `json:"question_ids,omitempty"`
+	IncludeTag          *bool               `json:"include_tag,omitempty"`
+	Closed              *bool               `json:"closed,omitempty"`
 }
 
 // GetMarketByIDQueryParams represents query parameters for fetching a single market by ID
@@ -273,16 +400,16 @@ type GetMarketByIDQueryParams struct {
 
 // GetSeriesParams represents parameters for fetching series list
 type GetSeriesParams struct {
-	Limit            int      `json:"limit,omitempty"`  // Maximum number of series to return
-	Offset           int      `json:"offset,omitempty"` // Pagination offset
-	Order            string   `json:"order,omitempty"`  // Comma-separated list of fields to order by
-	Ascending        *bool    `json:"ascending,omitempty"`
-	Slug             []string `json:"slug,omitempty"`
-	CategoriesIDs    []int    `json:"categories_ids,omitempty"`
-	CategoriesLabels []string `json:"categories_labels,omitempty"`
-	Closed           *bool    `json:"closed,omitempty"`
-	IncludeChat      *bool    `json:"include_chat,omitempty"`
-	Recurrence       string   `json:"recurrence,omitempty"`
+	Limit            int        `json:"limit,omitempty"`  // Maximum number of series to return
+	Offset           int        `json:"offset,omitempty"` // Pagination offset
+	Order            OrderField `json:"order,omitempty"`  // Comma-separated list of fields to order by
+	Ascending        *bool      `json:"ascending,omitempty"`
+	Slug             []string   `json:"slug,omitempty"`
+	CategoriesIDs    []int      `json:"categories_ids,omitempty"`
+	CategoriesLabels []string   `json:"categories_labels,omitempty"`
+	Closed           *bool      `json:"closed,omitempty"`
+	IncludeChat      *bool      `json:"include_chat,omitempty"`
+	Recurrence       Recurrence `json:"recurrence,omitempty"`
 }
 
 // GetEventByIDQueryParams represents query parameters for fetching a single event by ID
@@ -304,23 +431,23 @@ type GetSeriesByIDQueryParams struct {
 
 // GetTeamsParams represents query parameters for fetching teams
 type GetTeamsParams struct {
-	Limit        int      `json:"limit,omitempty"`        // Number of results to return (x >= 0)
-	Offset       int      `json:"offset,omitempty"`       // Number of results to skip (x >= 0)
-	Order        string   `json:"order,omitempty"`        // Comma-separated list of fields to order by
-	Ascending    *bool    `json:"ascending,omitempty"`    // Sort order
-	League       []string `json:"league,omitempty"`       // Filter by league(s)
-	Name         []string `json:"name,omitempty"`         // Filter by name(s)
-	Abbreviation []string `json:"abbreviation,omitempty"` // Filter by abbreviation(s)
+	Limit        int        `json:"limit,omitempty"`        // Number of results to return (x >= 0)
+	Offset       int        `json:"offset,omitempty"`       // Number of results to skip (x >= 0)
+	Order        OrderField `json:"order,omitempty"`        // Comma-separated list of fields to order by
+	Ascending    *bool      `json:"ascending,omitempty"`    // Sort order
+	League       []string   `json:"league,omitempty"`       // Filter by league(s)
+	Name         []string   `json:"name,omitempty"`         // Filter by name(s)
+	Abbreviation []string   `json:"abbreviation,omitempty"` // Filter by abbreviation(s)
 }
 
 // GetTagsParams represents query parameters for fetching tags
 type GetTagsParams struct {
-	Limit           int    `json:"limit,omitempty"`            // Number of results to return (x >= 0)
-	Offset          int    `json:"offset,omitempty"`           // Number of results to skip (x >= 0)
-	Order           string `json:"order,omitempty"`            // Comma-separated list of fields to order by
-	Ascending       *bool  `json:"ascending,omitempty"`        // Sort order
-	IncludeTemplate *bool  `json:"include_template,omitempty"` // Include template information
-	IsCarousel      *bool  `json:"is_carousel,omitempty"`      // Filter by carousel status
+	Limit           int        `json:"limit,omitempty"`            // Number of results to return (x >= 0)
+	Offset          int        `json:"offset,omitempty"`           // Number of results to skip (x >= 0)
+	Order           OrderField `json:"order,omitempty"`            // Comma-separated list of fields to order by
+	Ascending       *bool      `json:"ascending,omitempty"`        // Sort order
+	IncludeTemplate *bool      `json:"include_template,omitempty"` // Include template information
+	IsCarousel      *bool      `json:"is_carousel,omitempty"`      // Filter by carousel status
 }
 
 // GetTagByIDQueryParams represents query parameters for fetching a single tag by ID
@@ -341,20 +468,20 @@ type GetRelatedTagsParams struct {
 
 // SearchParams represents parameters for searching markets, events, and profiles
 type SearchParams struct {
-	Q                 string   `json:"q"`                             // Search query (required)
-	Cache             *bool    `json:"cache,omitempty"`               // Use cache
-	EventsStatus      string   `json:"events_status,omitempty"`       // Events status filter
-	LimitPerType      *int     `json:"limit_per_type,omitempty"`      // Limit results per type
-	Page              *int     `json:"page,omitempty"`                // Page number for pagination
-	EventsTag         []string `json:"events_tag,omitempty"`          // Filter by event tags
-	KeepClosedMarkets *int     `json:"keep_closed_markets,omitempty"` // Keep closed markets (0 or 1)
-	Sort              string   `json:"sort,omitempty"`                // Sort field
-	Ascending         *bool    `json:"ascending,omitempty"`           // Sort order
-	SearchTags        *bool    `json:"search_tags,omitempty"`         // Include tags in search
-	SearchProfiles    *bool    `json:"search_profiles,omitempty"`     // Include profiles in search
-	Recurrence        string   `json:"recurrence,omitempty"`          // Recurrence filter
-	ExcludeTagID      []int    `json:"exclude_tag_id,omitempty"`      // Exclude tag IDs
-	Optimized         *bool    `json:"optimized,omitempty"`           // Return optimized images
+	Q                 string       `json:"q"`                             // Search query (required)
+	Cache             *bool        `json:"cache,omitempty"`               // Use cache
+	EventsStatus      EventsStatus `json:"events_status,omitempty"`       // Events status filter
+	LimitPerType      *int         `json:"limit_per_type,omitempty"`      // Limit results per type
+	Page              *int         `json:"page,omitempty"`                // Page number for pagination
+	EventsTag         []string     `json:"events_tag,omitempty"`          // Filter by event tags
+	KeepClosedMarkets *int         `json:"keep_closed_markets,omitempty"` // Keep closed markets (0 or 1)
+	Sort              OrderField   `json:"sort,omitempty"`                // Sort field
+	Ascending         *bool        `json:"ascending,omitempty"`           // Sort order
+	SearchTags        *bool        `json:"search_tags,omitempty"`         // Include tags in search
+	SearchProfiles    *bool        `json:"search_profiles,omitempty"`     // Include profiles in search
+	Recurrence        Recurrence   `json:"recurrence,omitempty"`          // Recurrence filter
+	ExcludeTagID      []int        `json:"exclude_tag_id,omitempty"`      // Exclude tag IDs
+	Optimized         *bool        `json:"optimized,omitempty"`           // Return optimized images
 }
 
 // SearchResponse represents the response from the search endpoint
@@ -433,14 +560,14 @@ type Market struct {
 	ClosedTime       NormalizedTime `json:"closedTime"`
 
 	// Market mechanics
-	Category          string  `json:"category"`
-	AmmType           string  `json:"ammType"`
-	Liquidity         string  `json:"liquidity"`
-	LiquidityNum      float64 `json:"liquidityNum"`
-	Volume            string  `json:"volume"`
-	VolumeNum         float64 `json:"volumeNum"`
-	Fee               string  `json:"fee"`
-	DenominationToken string  `json:"denominationToken"`
+	Category          string     `json:"category"`
+	AmmType           string     `json:"ammType"`
+	Liquidity         FlexString `json:"liquidity"`
+	LiquidityNum      FlexFloat  `json:"liquidityNum"`
+	Volume            FlexString `json:"volume"`
+	VolumeNum         FlexFloat  `json:"volumeNum"`
+	Fee               FlexString `json:"fee"`
+	DenominationToken string     `json:"denominationToken"`
 
 	// Sponsor information
 	SponsorName  string `json:"sponsorName"`
@@ -475,8 +602,8 @@ type Market struct {
 	Funded     bool `json:"funded"`
 
 	// Market type and format
-	MarketType string `json:"marketType"`
-	FormatType string `json:"formatType"`
+	MarketType MarketType `json:"marketType"`
+	FormatType FormatType `json:"formatType"`
 
 	// Date boundaries
 	LowerBoundDate NormalizedTime `json:"lowerBoundDate"`
@@ -502,23 +629,23 @@ type Market struct {
 	GroupItemRange     string `json:"groupItemRange"`
 
 	// UMA resolution
-	UMAResolutionStatus   string `json:"umaResolutionStatus"`
-	UMAResolutionStatuses string `json:"umaResolutionStatuses"`
-	UMABond               string `json:"umaBond"`
-	UMAReward             string `json:"umaReward"`
+	UMAResolutionStatus   UMAResolutionStatus `json:"umaResolutionStatus"`
+	UMAResolutionStatuses string              `json:"umaResolutionStatuses"`
+	UMABond               FlexString          `json:"umaBond"`
+	UMAReward             FlexString          `json:"umaReward"`
 
 	// Order book configuration
-	EnableOrderBook       bool    `json:"enableOrderBook"`
-	OrderPriceMinTickSize float64 `json:"orderPriceMinTickSize"`
-	OrderMinSize          float64 `json:"orderMinSize"`
-	MakerBaseFee          int     `json:"makerBaseFee"`
-	TakerBaseFee          int     `json:"takerBaseFee"`
-	AcceptingOrders       bool    `json:"acceptingOrders"`
-	NotificationsEnabled  bool    `json:"notificationsEnabled"`
+	EnableOrderBook       bool      `json:"enableOrderBook"`
+	OrderPriceMinTickSize FlexFloat `json:"orderPriceMinTickSize"`
+	OrderMinSize          FlexFloat `json:"orderMinSize"`
+	MakerBaseFee          FlexInt   `json:"makerBaseFee"`
+	TakerBaseFee          FlexInt   `json:"takerBaseFee"`
+	AcceptingOrders       bool      `json:"acceptingOrders"`
+	NotificationsEnabled  bool      `json:"notificationsEnabled"`
 
 	// Curation and scoring
-	CurationOrder int     `json:"curationOrder"`
-	Score         float64 `json:"score"`
+	CurationOrder FlexInt   `json:"curationOrder"`
+	Score         FlexFloat `json:"score"`
 
 	// Review status
 	HasReviewedDates bool `json:"hasReviewedDates"`
@@ -526,34 +653,34 @@ type Market struct {
 	CommentsEnabled  bool `json:"commentsEnabled"`
 
 	// Volume metrics
-	Volume24hr     float64 `json:"volume24hr"`
-	Volume1wk      float64 `json:"volume1wk"`
-	Volume1mo      float64 `json:"volume1mo"`
-	Volume1yr      float64 `json:"volume1yr"`
-	Volume24hrAmm  float64 `json:"volume24hrAmm"`
-	Volume1wkAmm   float64 `json:"volume1wkAmm"`
-	Volume1moAmm   float64 `json:"volume1moAmm"`
-	Volume1yrAmm   float64 `json:"volume1yrAmm"`
-	Volume24hrClob float64 `json:"volume24hrClob"`
-	Volume1wkClob  float64 `json:"volume1wkClob"`
-	Volume1moClob  float64 `json:"volume1moClob"`
-	Volume1yrClob  float64 `json:"volume1yrClob"`
-	VolumeAmm      float64 `json:"volumeAmm"`
-	VolumeClob     float64 `json:"volumeClob"`
+	Volume24hr     FlexFloat `json:"volume24hr"`
+	Volume1wk      FlexFloat `json:"volume1wk"`
+	Volume1mo      FlexFloat `json:"volume1mo"`
+	Volume1yr      FlexFloat `json:"volume1yr"`
+	Volume24hrAmm  FlexFloat `json:"volume24hrAmm"`
+	Volume1wkAmm   FlexFloat `json:"volume1wkAmm"`
+	Volume1moAmm   FlexFloat `json:"volume1moAmm"`
+	Volume1yrAmm   FlexFloat `json:"volume1yrAmm"`
+	Volume24hrClob FlexFloat `json:"volume24hrClob"`
+	Volume1wkClob  FlexFloat `json:"volume1wkClob"`
+	Volume1moClob  FlexFloat `json:"volume1moClob"`
+	Volume1yrClob  FlexFloat `json:"volume1yrClob"`
+	VolumeAmm      FlexFloat `json:"volumeAmm"`
+	VolumeClob     FlexFloat `json:"volumeClob"`
 
 	// Liquidity breakdown
-	LiquidityAmm  float64 `json:"liquidityAmm"`
-	LiquidityClob float64 `json:"liquidityClob"`
+	LiquidityAmm  FlexFloat `json:"liquidityAmm"`
+	LiquidityClob FlexFloat `json:"liquidityClob"`
 
 	// Gaming/sports specific
-	GameStartTime    NormalizedTime `json:"gameStartTime"`
-	SecondsDelay     int            `json:"secondsDelay"`
-	ClobTokenIDs     string         `json:"clobTokenIds"`
-	TeamAID          string         `json:"teamAID"`
-	TeamBID          string         `json:"teamBID"`
-	GameID           string         `json:"gameId"`
-	SportsMarketType string         `json:"sportsMarketType"`
-	Line             float64        `json:"line"`
+	GameStartTime    NormalizedTime   `json:"gameStartTime"`
+	SecondsDelay     FlexInt          `json:"secondsDelay"`
+	ClobTokenIDs     string           `json:"clobTokenIds"`
+	TeamAID          string           `json:"teamAID"`
+	TeamBID          string           `json:"teamBID"`
+	GameID           string           `json:"gameId"`
+	SportsMarketType SportsMarketType `json:"sportsMarketType"`
+	Line             FlexFloat        `json:"line"`
 
 	// Discussions
 	DisqusThread string `json:"disqusThread"`
@@ -562,11 +689,11 @@ type Market struct {
 	FPMMLive bool `json:"fpmmLive"`
 
 	// Custom settings
-	CustomLiveness int `json:"customLiveness"`
+	CustomLiveness FlexInt `json:"customLiveness"`
 
 	// Rewards
-	RewardsMinSize   float64 `json:"rewardsMinSize"`
-	RewardsMaxSpread float64 `json:"rewardsMaxSpread"`
+	RewardsMinSize   FlexFloat `json:"rewardsMinSize"`
+	RewardsMaxSpread FlexFloat `json:"rewardsMaxSpread"`
 
 	// Image optimization
 	ImageOptimized *ImageOptimized `json:"imageOptimized,omitempty"`
@@ -585,25 +712,25 @@ type Market struct {
 	AcceptingOrdersTimestamp NormalizedTime `json:"acceptingOrdersTimestamp"`
 
 	// Competition
-	Competitive float64 `json:"competitive"`
+	Competitive FlexFloat `json:"competitive"`
 
 	// Spread information
-	Spread float64 `json:"spread"`
+	Spread FlexFloat `json:"spread"`
 
 	// Resolution flags
 	AutomaticallyResolved bool `json:"automaticallyResolved"`
 
 	// Price changes
-	OneDayPriceChange   float64 `json:"oneDayPriceChange"`
-	OneHourPriceChange  float64 `json:"oneHourPriceChange"`
-	OneWeekPriceChange  float64 `json:"oneWeekPriceChange"`
-	OneMonthPriceChange float64 `json:"oneMonthPriceChange"`
-	OneYearPriceChange  float64 `json:"oneYearPriceChange"`
+	OneDayPriceChange   FlexFloat `json:"oneDayPriceChange"`
+	OneHourPriceChange  FlexFloat `json:"oneHourPriceChange"`
+	OneWeekPriceChange  FlexFloat `json:"oneWeekPriceChange"`
+	OneMonthPriceChange FlexFloat `json:"oneMonthPriceChange"`
+	OneYearPriceChange  FlexFloat `json:"oneYearPriceChange"`
 
 	// Current prices
-	LastTradePrice float64 `json:"lastTradePrice"`
-	BestBid        float64 `json:"bestBid"`
-	BestAsk        float64 `json:"bestAsk"`
+	LastTradePrice FlexFloat `json:"lastTradePrice"`
+	BestBid        FlexFloat `json:"bestBid"`
+	BestAsk        FlexFloat `json:"bestAsk"`
 
 	// Activation
 	AutomaticallyActive bool `json:"automaticallyActive"`
@@ -630,6 +757,9 @@ type Market struct {
 
 	// Event timing (may be empty/null for backward compatibility)
 	EventStartTime NormalizedTime `json:"eventStartTime"`
+
+	// Raw is the original JSON of this object, kept only when drift detection runs with KeepRaw
+	Raw json.RawMessage `json:"-"`
 }
 
 // ImageOptimized represents an optimized image resource
@@ -666,9 +796,9 @@ type Event struct {
 	New               bool           `json:"new"`
 	Featured          bool           `json:"featured"`
 	Restricted        bool           `json:"restricted"`
-	Liquidity         float64        `json:"liquidity"`
-	Volume            float64        `json:"volume"`
-	OpenInterest      float64        `json:"openInterest"`
+	Liquidity         FlexFloat      `json:"liquidity"`
+	Volume            FlexFloat      `json:"volume"`
+	OpenInterest      FlexFloat      `json:"openInterest"`
 	SortBy            string         `json:"sortBy"`
 	Category          string         `json:"category"`
 	Subcategory       string         `json:"subcategory"`
@@ -680,21 +810,21 @@ type Event struct {
 	CreatedAt         NormalizedTime `json:"createdAt"`
 	UpdatedAt         NormalizedTime `json:"updatedAt"`
 	CommentsEnabled   bool           `json:"commentsEnabled"`
-	Competitive       float64        `json:"competitive"`
-	Volume24hr        float64        `json:"volume24hr"`
-	Volume1wk         float64        `json:"volume1wk"`
-	Volume1mo         float64        `json:"volume1mo"`
-	Volume1yr         float64        `json:"volume1yr"`
+	Competitive       FlexFloat      `json:"competitive"`
+	Volume24hr        FlexFloat      `json:"volume24hr"`
+	Volume1wk         FlexFloat      `json:"volume1wk"`
+	Volume1mo         FlexFloat      `json:"volume1mo"`
+	Volume1yr         FlexFloat      `json:"volume1yr"`
 	FeaturedImage     string         `json:"featuredImage"`
 	DisqusThread      string         `json:"disqusThread"`
 	ParentEvent       string         `json:"parentEvent"`
 	EnableOrderBook   bool           `json:"enableOrderBook"`
-	LiquidityAmm      float64        `json:"liquidityAmm"`
-	LiquidityClob     float64        `json:"liquidityClob"`
+	LiquidityAmm      FlexFloat      `json:"liquidityAmm"`
+	LiquidityClob     FlexFloat      `json:"liquidityClob"`
 	NegRisk           bool           `json:"negRisk"`
 	NegRiskMarketID   string         `json:"negRiskMarketID"`
-	NegRiskFeeBips    int            `json:"negRiskFeeBips"`
-	CommentCount      int            `json:"commentCount"`
+	NegRiskFeeBips    FlexInt        `json:"negRiskFeeBips"`
+	CommentCount      FlexInt        `json:"commentCount"`
 
 	// Optimized images
 	ImageOptimized         *ImageOptimized `json:"imageOptimized,omitempty"`
@@ -721,7 +851,7 @@ type Event struct {
 	StartTime                    NormalizedTime `json:"startTime"`
 	EventWeek                    int            `json:"eventWeek"`
 	SeriesSlug                   string         `json:"seriesSlug"`
-	Score                        string         `json:"score"`
+	Score                        FlexString     `json:"score"`
 	Elapsed                      string         `json:"elapsed"`
 	Period                       string         `json:"period"`
 	Live                         bool           `json:"live"`
@@ -736,14 +866,17 @@ type Event struct {
 	CantEstimate                 bool           `json:"cantEstimate"`
 	EstimatedValue               string         `json:"estimatedValue"`
 	Templates                    []Template     `json:"templates,omitempty"`
-	SpreadsMainLine              float64        `json:"spreadsMainLine"`
-	TotalsMainLine               float64        `json:"totalsMainLine"`
+	SpreadsMainLine              FlexFloat      `json:"spreadsMainLine"`
+	TotalsMainLine               FlexFloat      `json:"totalsMainLine"`
 	CarouselMap                  string         `json:"carouselMap"`
 	PendingDeployment            bool           `json:"pendingDeployment"`
 	Deploying                    bool           `json:"deploying"`
 	DeployingTimestamp           NormalizedTime `json:"deployingTimestamp"`
 	ScheduledDeploymentTimestamp NormalizedTime `json:"scheduledDeploymentTimestamp"`
 	GameStatus                   string         `json:"gameStatus"`
+
+	// Raw is the original JSON of this object, kept only when drift detection runs with KeepRaw
+	Raw json.RawMessage `json:"-"`
 }
 
 // Category represents a category or subcategory
@@ -772,6 +905,9 @@ type Tag struct {
 	UpdatedAt   NormalizedTime `json:"updatedAt"`
 	ForceHide   bool           `json:"forceHide"`
 	IsCarousel  bool           `json:"isCarousel"`
+
+	// Raw is the original JSON of this object, kept only when drift detection runs with KeepRaw
+	Raw json.RawMessage `json:"-"`
 }
 
 // Series represents a series of events
@@ -782,7 +918,7 @@ type Series struct {
 	Title             string         `json:"title"`
 	Subtitle          string         `json:"subtitle"`
 	SeriesType        string         `json:"seriesType"`
-	Recurrence        string         `json:"recurrence"`
+	Recurrence        Recurrence     `json:"recurrence"`
 	Description       string         `json:"description"`
 	Image             string         `json:"image"`
 	Icon              string         `json:"icon"`
@@ -801,20 +937,23 @@ type Series struct {
 	CreatedAt         NormalizedTime `json:"createdAt"`
 	UpdatedAt         NormalizedTime `json:"updatedAt"`
 	CommentsEnabled   bool           `json:"commentsEnabled"`
-	Competitive       string         `json:"competitive"`
-	Volume24hr        float64        `json:"volume24hr"`
-	Volume            float64        `json:"volume"`
-	Liquidity         float64        `json:"liquidity"`
+	Competitive       FlexFloat      `json:"competitive"`
+	Volume24hr        FlexFloat      `json:"volume24hr"`
+	Volume            FlexFloat      `json:"volume"`
+	Liquidity         FlexFloat      `json:"liquidity"`
 	StartDate         NormalizedTime `json:"startDate"`
 	PythTokenID       string         `json:"pythTokenID"`
 	CGAssetName       string         `json:"cgAssetName"`
-	Score             float64        `json:"score"`
+	Score             FlexFloat      `json:"score"`
 	Events            []Event        `json:"events,omitempty"`
 	Collections       []Collection   `json:"collections,omitempty"`
 	Categories        []Category     `json:"categories,omitempty"`
 	Tags              []Tag          `json:"tags,omitempty"`
-	CommentCount      int            `json:"commentCount"`
+	CommentCount      FlexInt        `json:"commentCount"`
 	Chats             []Chat         `json:"chats,omitempty"`
+
+	// Raw is the original JSON of this object, kept only when drift detection runs with KeepRaw
+	Raw json.RawMessage `json:"-"`
 }
 
 // Collection represents a collection of events
@@ -899,6 +1038,9 @@ type Team struct {
 	Alias        string         `json:"alias"`
 	CreatedAt    NormalizedTime `json:"createdAt"`
 	UpdatedAt    NormalizedTime `json:"updatedAt"`
+
+	// Raw is the original JSON of this object, kept only when drift detection runs with KeepRaw
+	Raw json.RawMessage `json:"-"`
 }
 
 // SportMetadata represents metadata information for a sport
@@ -2,6 +2,7 @@ package polymarketgamma
 
 import (
 	"encoding/json"
+	"math"
 	"reflect"
 	"testing"
 )
@@ -361,3 +362,131 @@ func TestStringOrArray_RealWorldScenarios(t *testing.T) {
 		})
 	}
 }
+
+func TestFlexFloat_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected FlexFloat
+		wantErr  bool
+	}{
+		{name: "number", input: `12.5`, expected: 12.5},
+		{name: "numeric string", input: `"12.5"`, expected: 12.5},
+		{name: "padded string", input: `" 7 "`, expected: 7},
+		{name: "exponent", input: `"1e3"`, expected: 1000},
+		{name: "empty string", input: `""`, expected: 0},
+		{name: "null", input: `null`, expected: 0},
+		{name: "non-numeric string", input: `"high"`, wantErr: true},
+		{name: "bool", input: `true`, wantErr: true},
+		{name: "object", input: `{}`, wantErr: true},
+		{name: "NaN string", input: `"NaN"`, wantErr: true},
+		{name: "Inf string", input: `"Inf"`, wantErr: true},
+		{name: "negative infinity string", input: `"-Infinity"`, wantErr: true},
+		{name: "overflow", input: `"1e400"`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got FlexFloat
+			err := json.Unmarshal([]byte(tt.input), &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unmarshal(%s) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.expected {
+				t.Errorf("Unmarshal(%s) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFlexInt_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected FlexInt
+		wantErr  bool
+	}{
+		{name: "number", input: `42`, expected: 42},
+		{name: "numeric string", input: `"-3"`, expected: -3},
+		{name: "integral float", input: `3.0`, expected: 3},
+		{name: "empty string", input: `""`, expected: 0},
+		{name: "null", input: `null`, expected: 0},
+		{name: "fractional", input: `3.5`, wantErr: true},
+		{name: "non-numeric string", input: `"n/a"`, wantErr: true},
+		{name: "min int64", input: `"-9223372036854775808"`, expected: math.MinInt64},
+		{name: "min int64 as float", input: `-9.223372036854775808e18`, expected: math.MinInt64},
+		{name: "2^63", input: `9223372036854775808`, wantErr: true},
+		{name: "2^63 as float", input: `9.223372036854775808e18`, wantErr: true},
+		{name: "below min int64", input: `-1e19`, wantErr: true},
+		{name: "NaN string", input: `"NaN"`, wantErr: true},
+		{name: "Inf string", input: `"+Inf"`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got FlexInt
+			err := json.Unmarshal([]byte(tt.input), &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unmarshal(%s) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.expected {
+				t.Errorf("Unmarshal(%s) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFlexString_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected FlexString
+		wantErr  bool
+	}{
+		{input: `"1234.50"`, expected: "1234.50"},
+		{input: `1234.50`, expected: "1234.50"},
+		{input: `"2-1"`, expected: "2-1"},
+		{input: `null`, expected: ""},
+		{input: `[1]`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		var got FlexString
+		err := json.Unmarshal([]byte(tt.input), &got)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("Unmarshal(%s) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+		}
+		if !tt.wantErr && got != tt.expected {
+			t.Errorf("Unmarshal(%s) = %q, want %q", tt.input, got, tt.expected)
+		}
+	}
+}
+
+func TestFlexFields_MixedEncodings(t *testing.T) {
+	// The same fields arrive as numbers on one record and strings on the next
+	data := `[
+		{"id":"1","liquidity":1500.5,"volume":"2500","competitive":0.9,"score":"3-1","commentCount":"12",
+		 "markets":[{"id":"10","liquidity":1500.5,"bestBid":"0.61","makerBaseFee":"0","spread":""}],
+		 "series":[{"id":"s1","competitive":"0.75","score":null,"volume":12}]},
+		{"id":"2","liquidity":"","volume":null,"competitive":"0.1","score":2,"commentCount":4}
+	]`
+
+	var events []Event
+	if err := json.Unmarshal([]byte(data), &events); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+
+	if events[0].Liquidity != 1500.5 || events[0].Volume != 2500 || events[0].CommentCount != 12 || events[0].Score != "3-1" {
+		t.Errorf("event 0 = %+v", events[0])
+	}
+	if events[1].Liquidity != 0 || events[1].Competitive != 0.1 || events[1].Score != "2" {
+		t.Errorf("event 1 = %+v", events[1])
+	}
+
+	market := events[0].Markets[0]
+	if market.Liquidity != "1500.5" || market.BestBid != 0.61 || market.Spread != 0 {
+		t.Errorf("market = %+v", market)
+	}
+	if series := events[0].Series[0]; series.Competitive != 0.75 || series.Volume != 12 {
+		t.Errorf("series = %+v", series)
+	}
+}